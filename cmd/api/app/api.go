@@ -28,29 +28,46 @@ import (
 
 	"github.com/karmada-io/dashboard/cmd/api/app/options"
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
-	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/auth"                     // Importing route packages forces route registration
-	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/cluster"                  // Importing route packages forces route registration
-	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/clusteroverridepolicy"    // Importing route packages forces route registration
-	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/clusterpropagationpolicy" // Importing route packages forces route registration
-	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/config"                   // Importing route packages forces route registration
-	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/configmap"                // Importing route packages forces route registration
-	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/cronjob"                  // Importing route packages forces route registration
-	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/daemonset"                // Importing route packages forces route registration
-	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/deployment"               // Importing route packages forces route registration
-	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/ingress"                  // Importing route packages forces route registration
-	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/job"                      // Importing route packages forces route registration
-	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/member"                   // Importing route packages forces route registration
-	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/namespace"                // Importing route packages forces route registration
-	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/overridepolicy"           // Importing route packages forces route registration
-	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/overview"                 // Importing route packages forces route registration
-	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/propagationpolicy"        // Importing route packages forces route registration
-	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/secret"                   // Importing route packages forces route registration
-	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/service"                  // Importing route packages forces route registration
-	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/statefulset"              // Importing route packages forces route registration
-	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/unstructured"             // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/aggregated"                              // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/app"                                     // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/auth"                                    // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/cluster"                                 // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/clusteroverridepolicy"                   // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/clusterpropagationpolicy"                // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/config"                                  // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/configmap"                               // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/cronfederatedhpa"                        // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/cronjob"                                 // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/daemonset"                               // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/deployment"                              // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/failover"                                // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/federatedhpa"                            // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/federatedresourcequota"                  // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/ingress"                                 // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/job"                                     // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/log"                                     // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/member"                                  // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/multiclusteringress"                     // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/multiclusterservice"                     // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/namespace"                               // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/overridepolicy"                          // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/overview"                                // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/propagationpolicy"                       // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/resourceinterpretercustomization"        // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/resourceinterpreterwebhookconfiguration" // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/resourceregistry"                        // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/search"                                  // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/secret"                                  // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/service"                                 // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/statefulset"                             // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/terminal"                                // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/unstructured"                            // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/workload"                                // Importing route packages forces route registration
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/workloadrebalancer"                      // Importing route packages forces route registration
 	"github.com/karmada-io/dashboard/pkg/client"
 	"github.com/karmada-io/dashboard/pkg/config"
 	"github.com/karmada-io/dashboard/pkg/environment"
+	"github.com/karmada-io/dashboard/pkg/terminal"
 )
 
 // NewAPICommand creates a *cobra.Command object with default parameters
@@ -111,6 +128,7 @@ func run(ctx context.Context, opts *options.Options) error {
 	ensureAPIServerConnectionOrDie()
 	serve(opts)
 	config.InitDashboardConfig(client.InClusterClient(), ctx.Done())
+	terminal.InitWebTerminals(client.InClusterClient(), opts.Namespace, ctx.Done())
 	<-ctx.Done()
 	os.Exit(0)
 	return nil
@@ -42,3 +42,11 @@ func EnsureMemberClusterMiddleware() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// WebSocketAuthorizationMiddleware takes the bearer token of websocket requests from their subprotocols.
+func WebSocketAuthorizationMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		client.SetAuthorizationHeaderFromWebSocketProtocol(c.Request)
+		c.Next()
+	}
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregated
+
+import (
+	"github.com/gin-gonic/gin"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/resource/deployment"
+	"github.com/karmada-io/dashboard/pkg/resource/node"
+	"github.com/karmada-io/dashboard/pkg/resource/pod"
+)
+
+// searchClient returns the client for the proxy of karmada-search, the resources of all the member clusters
+// are listed in one call. Only the resources selected by a ResourceRegistry are aggregated, the other ones
+// are listed from karmada apiserver. The request fails when karmada-search is not installed.
+func searchClient(c *gin.Context) kubernetes.Interface {
+	proxyClient := client.InClusterClientForKarmadaSearchProxy()
+	if proxyClient == nil {
+		common.Fail(c, errors.NewBadRequest("karmada-search is not installed"))
+	}
+	return proxyClient
+}
+
+func handleGetStatus(c *gin.Context) {
+	common.Success(c, gin.H{
+		"enabled": client.IsKarmadaSearchEnabled(),
+	})
+}
+
+func handleGetAggregatedPods(c *gin.Context) {
+	proxyClient := searchClient(c)
+	if proxyClient == nil {
+		return
+	}
+	namespace := common.ParseNamespacePathParameter(c)
+	dataSelect := common.ParseDataSelectPathParameter(c)
+	result, err := pod.GetPodList(proxyClient, namespace, dataSelect)
+	if err != nil {
+		klog.ErrorS(err, "Failed to list aggregated pods")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleGetAggregatedDeployments(c *gin.Context) {
+	proxyClient := searchClient(c)
+	if proxyClient == nil {
+		return
+	}
+	namespace := common.ParseNamespacePathParameter(c)
+	dataSelect := common.ParseDataSelectPathParameter(c)
+	result, err := deployment.GetAggregatedDeploymentList(proxyClient, namespace, dataSelect)
+	if err != nil {
+		klog.ErrorS(err, "Failed to list aggregated deployments")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleGetAggregatedNodes(c *gin.Context) {
+	proxyClient := searchClient(c)
+	if proxyClient == nil {
+		return
+	}
+	dataSelect := common.ParseDataSelectPathParameter(c)
+	result, err := node.GetNodeList(proxyClient, dataSelect)
+	if err != nil {
+		klog.ErrorS(err, "Failed to list aggregated nodes")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func init() {
+	r := router.V1()
+	r.GET("/aggregated/status", handleGetStatus)
+	r.GET("/aggregated/pod", handleGetAggregatedPods)
+	r.GET("/aggregated/pod/:namespace", handleGetAggregatedPods)
+	r.GET("/aggregated/deployment", handleGetAggregatedDeployments)
+	r.GET("/aggregated/deployment/:namespace", handleGetAggregatedDeployments)
+	r.GET("/aggregated/node", handleGetAggregatedNodes)
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"github.com/gin-gonic/gin"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/config"
+	"github.com/karmada-io/dashboard/pkg/resource/app"
+)
+
+// handlePreviewApp returns the manifests generated for an app for review, nothing is created.
+func handlePreviewApp(c *gin.Context) {
+	req := new(v1.AppTemplateRequest)
+	if err := c.ShouldBind(req); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	manifests, err := app.Generate(req.Spec, config.GetDashboardConfig().DockerRegistries)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, manifests)
+}
+
+func handleCreateApp(c *gin.Context) {
+	req := new(v1.AppTemplateRequest)
+	if err := c.ShouldBind(req); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	manifests, err := app.Generate(req.Spec, config.GetDashboardConfig().DockerRegistries)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if err = app.Apply(c, client.InClusterRuntimeClientForKarmadaAPIServer(), manifests); err != nil {
+		klog.ErrorS(err, "Failed to create app", "namespace", manifests.Deployment.Namespace, "name", manifests.Deployment.Name)
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, manifests)
+}
+
+func init() {
+	r := router.V1()
+	r.POST("/app/preview", handlePreviewApp)
+	r.POST("/app", handleCreateApp)
+}
@@ -34,8 +34,11 @@ import (
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
 	"github.com/karmada-io/dashboard/pkg/client"
 	"github.com/karmada-io/dashboard/pkg/resource/cluster"
+	"github.com/karmada-io/dashboard/pkg/resource/failover"
 )
 
+var clusterKind = v1alpha1.SchemeGroupVersion.WithKind("Cluster").GroupKind()
+
 func handleGetClusterList(c *gin.Context) {
 	karmadaClient := client.InClusterKarmadaClient()
 	dataSelect := common.ParseDataSelectPathParameter(c)
@@ -151,18 +154,42 @@ func handlePutCluster(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	memberCluster.ResourceVersion = clusterRequest.ResourceVersion
+	applyPutClusterRequest(memberCluster, clusterRequest)
+
+	errs := cluster.ValidateClusterSpec(&memberCluster.Spec)
+	secretErrs, err := cluster.ValidateClusterSecretRefs(client.InClusterClientForKarmadaAPIServer(), &memberCluster.Spec)
+	if err != nil {
+		klog.ErrorS(err, "Validate cluster secret refs failed")
+		common.Fail(c, err)
+		return
+	}
+	if errs = append(errs, secretErrs...); len(errs) > 0 {
+		common.Fail(c, apierrors.NewInvalid(clusterKind, name, errs))
+		return
+	}
 
+	updated, err := karmadaClient.ClusterV1alpha1().Clusters().Update(context.TODO(), memberCluster, metav1.UpdateOptions{})
+	if err != nil {
+		klog.ErrorS(err, "Update cluster failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, v1.PutClusterResponse{ResourceVersion: updated.ResourceVersion})
+}
+
+// applyPutClusterRequest changes the cluster with the fields set in the request.
+func applyPutClusterRequest(memberCluster *v1alpha1.Cluster, clusterRequest *v1.PutClusterRequest) {
 	// assume that the frontend can fetch the whole labels and taints
-	labels := make(map[string]string)
 	if clusterRequest.Labels != nil {
+		labels := make(map[string]string)
 		for _, labelItem := range *clusterRequest.Labels {
 			labels[labelItem.Key] = labelItem.Value
 		}
 		memberCluster.Labels = labels
 	}
-
-	taints := make([]corev1.Taint, 0)
 	if clusterRequest.Taints != nil {
+		taints := make([]corev1.Taint, 0)
 		for _, taintItem := range *clusterRequest.Taints {
 			taints = append(taints, corev1.Taint{
 				Key:    taintItem.Key,
@@ -173,13 +200,36 @@ func handlePutCluster(c *gin.Context) {
 		memberCluster.Spec.Taints = taints
 	}
 
-	_, err = karmadaClient.ClusterV1alpha1().Clusters().Update(context.TODO(), memberCluster, metav1.UpdateOptions{})
-	if err != nil {
-		klog.ErrorS(err, "Update cluster failed")
-		common.Fail(c, err)
-		return
+	spec := &memberCluster.Spec
+	if clusterRequest.Provider != nil {
+		spec.Provider = *clusterRequest.Provider
+	}
+	if clusterRequest.Region != nil {
+		spec.Region = *clusterRequest.Region
+	}
+	if clusterRequest.Zones != nil {
+		// Zone is deprecated and can not co-exist with Zones.
+		spec.Zone = ""
+		spec.Zones = *clusterRequest.Zones
+	}
+	if clusterRequest.ResourceModels != nil {
+		spec.ResourceModels = *clusterRequest.ResourceModels
+	}
+	if clusterRequest.ProxyURL != nil {
+		spec.ProxyURL = *clusterRequest.ProxyURL
+	}
+	if clusterRequest.ProxyHeader != nil {
+		spec.ProxyHeader = *clusterRequest.ProxyHeader
+	}
+	if clusterRequest.InsecureSkipTLSVerification != nil {
+		spec.InsecureSkipTLSVerification = *clusterRequest.InsecureSkipTLSVerification
+	}
+	if clusterRequest.SecretRef != nil {
+		spec.SecretRef = clusterRequest.SecretRef
+	}
+	if clusterRequest.ImpersonatorSecretRef != nil {
+		spec.ImpersonatorSecretRef = clusterRequest.ImpersonatorSecretRef
 	}
-	common.Success(c, "ok")
 }
 
 func handleDeleteCluster(c *gin.Context) {
@@ -225,6 +275,94 @@ func handleDeleteCluster(c *gin.Context) {
 	common.Success(c, "ok")
 }
 
+func handleGetClusterCapacity(c *gin.Context) {
+	karmadaClient := client.InClusterKarmadaClient()
+	result, err := cluster.GetClusterCapacity(karmadaClient, c.Param("name"))
+	if err != nil {
+		klog.ErrorS(err, "GetClusterCapacity failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleEstimateClusterReplicas(c *gin.Context) {
+	estimateRequest := new(v1.EstimateReplicasRequest)
+	if err := c.ShouldBind(estimateRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	karmadaClient := client.InClusterKarmadaClient()
+	result, err := cluster.EstimateClusterReplicas(karmadaClient, c.Param("name"), estimateRequest.ResourceRequest)
+	if err != nil {
+		klog.ErrorS(err, "EstimateClusterReplicas failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleCordonCluster(c *gin.Context) {
+	karmadaClient := client.InClusterKarmadaClient()
+	if err := failover.Cordon(karmadaClient, c.Param("name")); err != nil {
+		klog.ErrorS(err, "Cordon cluster failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func handleUncordonCluster(c *gin.Context) {
+	karmadaClient := client.InClusterKarmadaClient()
+	if err := failover.Uncordon(karmadaClient, c.Param("name")); err != nil {
+		klog.ErrorS(err, "Uncordon cluster failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func handleGetDrainPrecheck(c *gin.Context) {
+	karmadaClient := client.InClusterKarmadaClient()
+	result, err := failover.PrecheckDrain(karmadaClient, c.Param("name"))
+	if err != nil {
+		klog.ErrorS(err, "PrecheckDrain failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleDrainCluster(c *gin.Context) {
+	drainRequest := new(v1.DrainClusterRequest)
+	if err := c.ShouldBind(drainRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	karmadaClient := client.InClusterKarmadaClient()
+	result, err := failover.StartDrain(karmadaClient, c.Param("name"), failover.DrainOptions{
+		BatchSize:     drainRequest.BatchSize,
+		BatchInterval: time.Duration(drainRequest.BatchIntervalSeconds) * time.Second,
+		BatchTimeout:  time.Duration(drainRequest.BatchTimeoutSeconds) * time.Second,
+		Force:         drainRequest.Force,
+	})
+	if err != nil {
+		klog.ErrorS(err, "Drain cluster failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleGetDrainProgress(c *gin.Context) {
+	result, err := failover.GetDrainProgress(c.Param("name"))
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
 func parseEndpointFromKubeconfig(kubeconfigContents string) (string, error) {
 	restConfig, err := client.LoadeRestConfigFromKubeConfig(kubeconfigContents)
 	if err != nil {
@@ -240,4 +378,11 @@ func init() {
 	r.POST("/cluster", handlePostCluster)
 	r.PUT("/cluster/:name", handlePutCluster)
 	r.DELETE("/cluster/:name", handleDeleteCluster)
+	r.GET("/cluster/:name/capacity", handleGetClusterCapacity)
+	r.POST("/cluster/:name/capacity/estimate", handleEstimateClusterReplicas)
+	r.POST("/cluster/:name/cordon", handleCordonCluster)
+	r.POST("/cluster/:name/uncordon", handleUncordonCluster)
+	r.GET("/cluster/:name/drain/precheck", handleGetDrainPrecheck)
+	r.POST("/cluster/:name/drain", handleDrainCluster)
+	r.GET("/cluster/:name/drain", handleGetDrainProgress)
 }
@@ -20,19 +20,23 @@ import (
 	"context"
 
 	"github.com/gin-gonic/gin"
-	"github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
-	"sigs.k8s.io/yaml"
 
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
 	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
 	"github.com/karmada-io/dashboard/pkg/client"
 	"github.com/karmada-io/dashboard/pkg/resource/clusteroverridepolicy"
+	op "github.com/karmada-io/dashboard/pkg/resource/overridepolicy"
+	"github.com/karmada-io/dashboard/pkg/resource/policy"
 )
 
 func handleGetClusterOverridePolicyList(c *gin.Context) {
+	listRequest := new(v1.GetPolicyListRequest)
+	if err := c.ShouldBindQuery(listRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
 	karmadaClient := client.InClusterKarmadaClient()
 	dataSelect := common.ParseDataSelectPathParameter(c)
 	clusterOverrideList, err := clusteroverridepolicy.GetClusterOverridePolicyList(karmadaClient, dataSelect)
@@ -41,6 +45,16 @@ func handleGetClusterOverridePolicyList(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	if listRequest.Analyze {
+		if report, err := policy.GetAnalyzeReport(c, client.InClusterRuntimeClientForKarmadaAPIServer()); err != nil {
+			klog.ErrorS(err, "Failed to analyze policies")
+		} else {
+			for i := range clusterOverrideList.ClusterOverridePolicies {
+				item := &clusterOverrideList.ClusterOverridePolicies[i]
+				item.Warnings = report.Warnings(policy.OverridePolicyKey(true, "", item.ObjectMeta.Name))
+			}
+		}
+	}
 	common.Success(c, clusterOverrideList)
 }
 
@@ -64,26 +78,12 @@ func handlePostClusterOverridePolicy(c *gin.Context) {
 		return
 	}
 
-	var err error
-	karmadaClient := client.InClusterKarmadaClient()
-	if overridepolicyRequest.IsClusterScope {
-		clusterOverridePolicy := v1alpha1.ClusterOverridePolicy{}
-		if err = yaml.Unmarshal([]byte(overridepolicyRequest.OverrideData), &clusterOverridePolicy); err != nil {
-			klog.ErrorS(err, "Failed to unmarshal ClusterOverridePolicy")
-			common.Fail(c, err)
-			return
-		}
-		_, err = karmadaClient.PolicyV1alpha1().ClusterOverridePolicies().Create(ctx, &clusterOverridePolicy, metav1.CreateOptions{})
-	} else {
-		overridePolicy := v1alpha1.OverridePolicy{}
-		if err = yaml.Unmarshal([]byte(overridepolicyRequest.OverrideData), &overridePolicy); err != nil {
-			klog.ErrorS(err, "Failed to unmarshal OverridePolicy")
-			common.Fail(c, err)
-			return
-		}
-		_, err = karmadaClient.PolicyV1alpha1().OverridePolicies(overridepolicyRequest.Namespace).Create(ctx, &overridePolicy, metav1.CreateOptions{})
-	}
+	obj, err := op.Unmarshal(overridepolicyRequest.OverrideData, overridepolicyRequest.IsClusterScope, overridepolicyRequest.Namespace)
 	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if err = policy.Create(ctx, client.InClusterRuntimeClientForKarmadaAPIServer(), obj); err != nil {
 		klog.ErrorS(err, "Failed to create OverridePolicy")
 		common.Fail(c, err)
 		return
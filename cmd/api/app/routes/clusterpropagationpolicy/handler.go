@@ -20,19 +20,23 @@ import (
 	"context"
 
 	"github.com/gin-gonic/gin"
-	"github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
-	"sigs.k8s.io/yaml"
 
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
 	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
 	"github.com/karmada-io/dashboard/pkg/client"
 	"github.com/karmada-io/dashboard/pkg/resource/clusterpropagationpolicy"
+	"github.com/karmada-io/dashboard/pkg/resource/policy"
+	pp "github.com/karmada-io/dashboard/pkg/resource/propagationpolicy"
 )
 
 func handleGetClusterPropagationPolicyList(c *gin.Context) {
+	listRequest := new(v1.GetPolicyListRequest)
+	if err := c.ShouldBindQuery(listRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
 	karmadaClient := client.InClusterKarmadaClient()
 	dataSelect := common.ParseDataSelectPathParameter(c)
 	clusterPropagationList, err := clusterpropagationpolicy.GetClusterPropagationPolicyList(karmadaClient, dataSelect)
@@ -41,6 +45,16 @@ func handleGetClusterPropagationPolicyList(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	if listRequest.Analyze {
+		if report, err := policy.GetAnalyzeReport(c, client.InClusterRuntimeClientForKarmadaAPIServer()); err != nil {
+			klog.ErrorS(err, "Failed to analyze policies")
+		} else {
+			for i := range clusterPropagationList.ClusterPropagationPolicies {
+				item := &clusterPropagationList.ClusterPropagationPolicies[i]
+				item.Warnings = report.Warnings(policy.PropagationPolicyKey(true, "", item.ObjectMeta.Name))
+			}
+		}
+	}
 	common.Success(c, clusterPropagationList)
 }
 
@@ -64,26 +78,12 @@ func handlePostClusterPropagationPolicy(c *gin.Context) {
 		return
 	}
 
-	var err error
-	karmadaClient := client.InClusterKarmadaClient()
-	if propagationpolicyRequest.IsClusterScope {
-		clusterPropagationPolicy := v1alpha1.ClusterPropagationPolicy{}
-		if err = yaml.Unmarshal([]byte(propagationpolicyRequest.PropagationData), &clusterPropagationPolicy); err != nil {
-			klog.ErrorS(err, "Failed to unmarshal ClusterPropagationPolicy")
-			common.Fail(c, err)
-			return
-		}
-		_, err = karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Create(ctx, &clusterPropagationPolicy, metav1.CreateOptions{})
-	} else {
-		propagationPolicy := v1alpha1.PropagationPolicy{}
-		if err = yaml.Unmarshal([]byte(propagationpolicyRequest.PropagationData), &propagationPolicy); err != nil {
-			klog.ErrorS(err, "Failed to unmarshal PropagationPolicy")
-			common.Fail(c, err)
-			return
-		}
-		_, err = karmadaClient.PolicyV1alpha1().PropagationPolicies(propagationpolicyRequest.Namespace).Create(ctx, &propagationPolicy, metav1.CreateOptions{})
-	}
+	obj, err := pp.Unmarshal(propagationpolicyRequest.PropagationData, propagationpolicyRequest.IsClusterScope, propagationpolicyRequest.Namespace)
 	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if err = policy.Create(ctx, client.InClusterRuntimeClientForKarmadaAPIServer(), obj); err != nil {
 		klog.ErrorS(err, "Failed to create PropagationPolicy")
 		common.Fail(c, err)
 		return
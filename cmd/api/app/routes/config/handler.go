@@ -52,6 +52,9 @@ func SetDashboardConfig(c *gin.Context) {
 	if len(setDashboardConfigRequest.MenuConfigs) > 0 {
 		dashboardConfig.MenuConfigs = setDashboardConfigRequest.MenuConfigs
 	}
+	if setDashboardConfigRequest.Terminal != nil {
+		dashboardConfig.Terminal = *setDashboardConfigRequest.Terminal
+	}
 	k8sClient := client.InClusterClient()
 	err := config.UpdateDashboardConfig(k8sClient, dashboardConfig)
 	if err != nil {
@@ -0,0 +1,130 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cronfederatedhpa
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	autoscalingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/autoscaling/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/resource/cronfederatedhpa"
+)
+
+var cronFederatedHPAKind = autoscalingv1alpha1.SchemeGroupVersion.WithKind("CronFederatedHPA").GroupKind()
+
+func handleGetCronFederatedHPAList(c *gin.Context) {
+	karmadaClient := client.InClusterKarmadaClient()
+	dataSelect := common.ParseDataSelectPathParameter(c)
+	nsQuery := common.ParseNamespacePathParameter(c)
+	result, err := cronfederatedhpa.GetCronFederatedHPAList(karmadaClient, nsQuery, dataSelect)
+	if err != nil {
+		klog.ErrorS(err, "Failed to GetCronFederatedHPAList")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleGetCronFederatedHPADetail(c *gin.Context) {
+	karmadaClient := client.InClusterKarmadaClient()
+	namespace := c.Param("namespace")
+	name := c.Param("name")
+	result, err := cronfederatedhpa.GetCronFederatedHPADetail(karmadaClient, namespace, name)
+	if err != nil {
+		klog.ErrorS(err, "GetCronFederatedHPADetail failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handlePostCronFederatedHPA(c *gin.Context) {
+	ctx := context.Context(c)
+	cronFederatedHPARequest := new(v1.CronFederatedHPARequest)
+	if err := c.ShouldBind(cronFederatedHPARequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if errs := cronfederatedhpa.ValidateCronFederatedHPA(cronFederatedHPARequest.Namespace, cronFederatedHPARequest.Name, &cronFederatedHPARequest.Spec); len(errs) != 0 {
+		common.Fail(c, k8serrors.NewInvalid(cronFederatedHPAKind, cronFederatedHPARequest.Name, errs))
+		return
+	}
+	karmadaClient := client.InClusterKarmadaClient()
+	_, err := karmadaClient.AutoscalingV1alpha1().CronFederatedHPAs(cronFederatedHPARequest.Namespace).Create(ctx, &autoscalingv1alpha1.CronFederatedHPA{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: cronFederatedHPARequest.Namespace,
+			Name:      cronFederatedHPARequest.Name,
+		},
+		Spec: cronFederatedHPARequest.Spec,
+	}, metav1.CreateOptions{})
+	if err != nil {
+		klog.ErrorS(err, "Failed to create CronFederatedHPA")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func handlePutCronFederatedHPA(c *gin.Context) {
+	ctx := context.Context(c)
+	cronFederatedHPARequest := new(v1.CronFederatedHPARequest)
+	if err := c.ShouldBind(cronFederatedHPARequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if cronFederatedHPARequest.ResourceVersion == "" {
+		common.Fail(c, k8serrors.NewInvalid(cronFederatedHPAKind, cronFederatedHPARequest.Name, field.ErrorList{
+			field.Required(field.NewPath("metadata", "resourceVersion"), "the resourceVersion the CronFederatedHPA was read at is required"),
+		}))
+		return
+	}
+	if errs := cronfederatedhpa.ValidateCronFederatedHPA(cronFederatedHPARequest.Namespace, cronFederatedHPARequest.Name, &cronFederatedHPARequest.Spec); len(errs) != 0 {
+		common.Fail(c, k8serrors.NewInvalid(cronFederatedHPAKind, cronFederatedHPARequest.Name, errs))
+		return
+	}
+	karmadaClient := client.InClusterKarmadaClient()
+	cronFederatedHPA, err := karmadaClient.AutoscalingV1alpha1().CronFederatedHPAs(cronFederatedHPARequest.Namespace).Get(ctx, cronFederatedHPARequest.Name, metav1.GetOptions{})
+	if err == nil {
+		cronFederatedHPA.ResourceVersion = cronFederatedHPARequest.ResourceVersion
+		cronFederatedHPA.Spec = cronFederatedHPARequest.Spec
+		_, err = karmadaClient.AutoscalingV1alpha1().CronFederatedHPAs(cronFederatedHPARequest.Namespace).Update(ctx, cronFederatedHPA, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		klog.ErrorS(err, "Failed to update CronFederatedHPA")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func init() {
+	r := router.V1()
+	r.GET("/cronfederatedhpa", handleGetCronFederatedHPAList)
+	r.GET("/cronfederatedhpa/:namespace", handleGetCronFederatedHPAList)
+	r.GET("/cronfederatedhpa/:namespace/:name", handleGetCronFederatedHPADetail)
+	r.POST("/cronfederatedhpa", handlePostCronFederatedHPA)
+	r.PUT("/cronfederatedhpa", handlePutCronFederatedHPA)
+}
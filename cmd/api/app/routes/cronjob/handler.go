@@ -14,12 +14,15 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package deployment
+package cronjob
 
 import (
 	"github.com/gin-gonic/gin"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
 
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
 	"github.com/karmada-io/dashboard/pkg/client"
 	"github.com/karmada-io/dashboard/pkg/resource/cronjob"
@@ -40,7 +43,7 @@ func handleGetCronJob(c *gin.Context) {
 
 func handleGetCronJobDetail(c *gin.Context) {
 	namespace := c.Param("namespace")
-	name := c.Param("statefulset")
+	name := c.Param("cronjob")
 	k8sClient := client.InClusterClientForKarmadaAPIServer()
 	result, err := cronjob.GetCronJobDetail(k8sClient, namespace, name)
 	if err != nil {
@@ -52,7 +55,7 @@ func handleGetCronJobDetail(c *gin.Context) {
 
 func handleGetCronJobEvents(c *gin.Context) {
 	namespace := c.Param("namespace")
-	name := c.Param("statefulset")
+	name := c.Param("cronjob")
 	k8sClient := client.InClusterClientForKarmadaAPIServer()
 	dataSelect := common.ParseDataSelectPathParameter(c)
 	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name)
@@ -62,10 +65,86 @@ func handleGetCronJobEvents(c *gin.Context) {
 	}
 	common.Success(c, result)
 }
+
+func handleGetCronJobJobs(c *gin.Context) {
+	req := new(v1.GetCronJobJobsRequest)
+	if err := c.ShouldBindQuery(req); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	namespace := c.Param("namespace")
+	name := c.Param("cronjob")
+	dataSelect := common.ParseDataSelectPathParameter(c)
+	karmadaClient := client.InClusterKarmadaClient()
+	result, err := cronjob.GetPropagatedCronJobJobs(karmadaClient, memberClient, dataSelect, namespace, name, req.Active)
+	if err != nil {
+		klog.ErrorS(err, "Failed to get cronjob jobs", "namespace", namespace, "name", name)
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleTriggerCronJob(c *gin.Context) {
+	namespace := c.Param("namespace")
+	name := c.Param("cronjob")
+	karmadaClient := client.InClusterKarmadaClient()
+	result, err := cronjob.TriggerPropagatedCronJob(karmadaClient, memberClient, namespace, name)
+	if err != nil {
+		klog.ErrorS(err, "Failed to trigger cronjob", "namespace", namespace, "name", name)
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleSuspendCronJob(suspend bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		namespace := c.Param("namespace")
+		name := c.Param("cronjob")
+		k8sClient := client.InClusterClientForKarmadaAPIServer()
+		if err := cronjob.SuspendCronJob(k8sClient, namespace, name, suspend); err != nil {
+			klog.ErrorS(err, "Failed to suspend cronjob", "namespace", namespace, "name", name, "suspend", suspend)
+			common.Fail(c, err)
+			return
+		}
+		common.Success(c, "ok")
+	}
+}
+
+func handleGetCronJobSchedule(c *gin.Context) {
+	req := new(v1.GetCronJobScheduleRequest)
+	if err := c.ShouldBindQuery(req); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if req.Count == 0 {
+		req.Count = cronjob.DefaultNextRuns
+	}
+	namespace := c.Param("namespace")
+	name := c.Param("cronjob")
+	k8sClient := client.InClusterClientForKarmadaAPIServer()
+	result, err := cronjob.GetCronJobSchedule(k8sClient, namespace, name, req.Count)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func memberClient(cluster string) kubernetes.Interface {
+	return client.InClusterClientForMemberCluster(cluster)
+}
+
 func init() {
 	r := router.V1()
 	r.GET("/cronjob", handleGetCronJob)
 	r.GET("/cronjob/:namespace", handleGetCronJob)
-	r.GET("/cronjob/:namespace/:statefulset", handleGetCronJobDetail)
-	r.GET("/cronjob/:namespace/:statefulset/event", handleGetCronJobEvents)
+	r.GET("/cronjob/:namespace/:cronjob", handleGetCronJobDetail)
+	r.GET("/cronjob/:namespace/:cronjob/event", handleGetCronJobEvents)
+	r.GET("/cronjob/:namespace/:cronjob/job", handleGetCronJobJobs)
+	r.GET("/cronjob/:namespace/:cronjob/schedule", handleGetCronJobSchedule)
+	r.POST("/cronjob/:namespace/:cronjob/trigger", handleTriggerCronJob)
+	r.POST("/cronjob/:namespace/:cronjob/suspend", handleSuspendCronJob(true))
+	r.POST("/cronjob/:namespace/:cronjob/resume", handleSuspendCronJob(false))
 }
@@ -17,54 +17,38 @@ limitations under the License.
 package deployment
 
 import (
-	"context"
-
 	"github.com/gin-gonic/gin"
-	appsv1 "k8s.io/api/apps/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
-	"sigs.k8s.io/yaml"
+	"k8s.io/klog/v2"
 
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
 	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
 	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/common/types"
 	"github.com/karmada-io/dashboard/pkg/resource/deployment"
 	"github.com/karmada-io/dashboard/pkg/resource/event"
+	"github.com/karmada-io/dashboard/pkg/resource/workload"
 )
 
 func handlerCreateDeployment(c *gin.Context) {
-	ctx := context.Context(c)
 	createDeploymentRequest := new(v1.CreateDeploymentRequest)
 	if err := c.ShouldBind(&createDeploymentRequest); err != nil {
 		common.Fail(c, err)
 		return
 	}
-	if createDeploymentRequest.Namespace == "" {
-		createDeploymentRequest.Namespace = "default"
-	}
-
-	restConfig, _, err := client.GetKarmadaConfig()
-	if err != nil {
-		common.Fail(c, err)
-		return
-	}
-	clientset, err := kubernetes.NewForConfig(restConfig)
+	obj, err := workload.Decode(types.ResourceKindDeployment, createDeploymentRequest.Content, createDeploymentRequest.Namespace)
 	if err != nil {
 		common.Fail(c, err)
 		return
 	}
-	deployment := appsv1.Deployment{}
-	if err = yaml.Unmarshal([]byte(createDeploymentRequest.Content), &deployment); err != nil {
-		common.Fail(c, err)
-		return
-	}
-	result, err := clientset.AppsV1().Deployments(createDeploymentRequest.Namespace).Create(ctx, &deployment, metav1.CreateOptions{})
+	result, err := workload.Create(c, client.InClusterRuntimeClientForKarmadaAPIServer(), obj, nil)
 	if err != nil {
+		klog.ErrorS(err, "Failed to create deployment", "namespace", obj.GetNamespace(), "name", obj.GetName())
 		common.Fail(c, err)
 		return
 	}
-	common.Success(c, result)
+	common.Success(c, result.Object)
 }
 
 func handleGetDeployments(c *gin.Context) {
@@ -103,11 +87,69 @@ func handleGetDeploymentEvents(c *gin.Context) {
 	}
 	common.Success(c, result)
 }
+
+func handleGetDeploymentRevisions(c *gin.Context) {
+	namespace := c.Param("namespace")
+	name := c.Param("deployment")
+	karmadaClient := client.InClusterKarmadaClient()
+	result, err := deployment.GetRevisionHistory(karmadaClient, memberClient, namespace, name)
+	if err != nil {
+		klog.ErrorS(err, "Failed to get deployment revisions", "namespace", namespace, "name", name)
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleGetDeploymentRevisionDiff(c *gin.Context) {
+	req := new(v1.GetDeploymentRevisionDiffRequest)
+	if err := c.ShouldBindQuery(req); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	namespace := c.Param("namespace")
+	name := c.Param("deployment")
+	karmadaClient := client.InClusterKarmadaClient()
+	result, err := deployment.GetRevisionDiff(karmadaClient, memberClient, namespace, name, req.From, req.To)
+	if err != nil {
+		klog.ErrorS(err, "Failed to diff deployment revisions", "namespace", namespace, "name", name)
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleRollbackDeployment(c *gin.Context) {
+	req := new(v1.RollbackDeploymentRequest)
+	if err := c.ShouldBind(req); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	namespace := c.Param("namespace")
+	name := c.Param("deployment")
+	k8sClient := client.InClusterClientForKarmadaAPIServer()
+	karmadaClient := client.InClusterKarmadaClient()
+	result, err := deployment.Rollback(k8sClient, karmadaClient, memberClient, namespace, name, req.Hash)
+	if err != nil {
+		klog.ErrorS(err, "Failed to rollback deployment", "namespace", namespace, "name", name, "hash", req.Hash)
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func memberClient(cluster string) kubernetes.Interface {
+	return client.InClusterClientForMemberCluster(cluster)
+}
+
 func init() {
 	r := router.V1()
 	r.GET("/deployment", handleGetDeployments)
 	r.GET("/deployment/:namespace", handleGetDeployments)
 	r.GET("/deployment/:namespace/:deployment", handleGetDeploymentDetail)
 	r.GET("/deployment/:namespace/:deployment/event", handleGetDeploymentEvents)
+	r.GET("/deployment/:namespace/:deployment/revision", handleGetDeploymentRevisions)
+	r.GET("/deployment/:namespace/:deployment/revision/diff", handleGetDeploymentRevisionDiff)
+	r.POST("/deployment/:namespace/:deployment/rollback", handleRollbackDeployment)
 	r.POST("/deployment", handlerCreateDeployment)
 }
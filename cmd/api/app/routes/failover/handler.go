@@ -0,0 +1,109 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package failover
+
+import (
+	"github.com/gin-gonic/gin"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/resource/failover"
+)
+
+func handleGetFailoverOverview(c *gin.Context) {
+	karmadaClient := client.InClusterKarmadaClient()
+	result, err := failover.GetFailoverOverview(karmadaClient)
+	if err != nil {
+		klog.ErrorS(err, "Failed to GetFailoverOverview")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleGetPolicyFailoverList(c *gin.Context) {
+	karmadaClient := client.InClusterKarmadaClient()
+	result, err := failover.GetPolicyFailoverList(karmadaClient)
+	if err != nil {
+		klog.ErrorS(err, "Failed to GetPolicyFailoverList")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleGetClusterFailoverDetail(c *gin.Context) {
+	karmadaClient := client.InClusterKarmadaClient()
+	kubeClient := client.InClusterClientForKarmadaAPIServer()
+	name := c.Param("name")
+	result, err := failover.GetClusterFailoverDetail(karmadaClient, kubeClient, name)
+	if err != nil {
+		klog.ErrorS(err, "Failed to GetClusterFailoverDetail")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleAddEvictionTaints(c *gin.Context) {
+	taintRequest := new(v1.AddEvictionTaintRequest)
+	if err := c.ShouldBind(taintRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	taints := make([]corev1.Taint, 0, len(taintRequest.Taints))
+	for _, taint := range taintRequest.Taints {
+		taints = append(taints, corev1.Taint{Key: taint.Key, Value: taint.Value})
+	}
+	karmadaClient := client.InClusterKarmadaClient()
+	result, err := failover.AddEvictionTaints(karmadaClient, c.Param("name"), taints, taintRequest.DryRun)
+	if err != nil {
+		klog.ErrorS(err, "Failed to add eviction taints")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleRemoveEvictionTaints(c *gin.Context) {
+	taintRequest := new(v1.RemoveEvictionTaintRequest)
+	if err := c.ShouldBind(taintRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	karmadaClient := client.InClusterKarmadaClient()
+	result, err := failover.RemoveEvictionTaints(karmadaClient, c.Param("name"), taintRequest.Keys, taintRequest.DryRun)
+	if err != nil {
+		klog.ErrorS(err, "Failed to remove eviction taints")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func init() {
+	r := router.V1()
+	r.GET("/failover", handleGetFailoverOverview)
+	r.GET("/failover/policy", handleGetPolicyFailoverList)
+	r.GET("/failover/cluster/:name", handleGetClusterFailoverDetail)
+	r.POST("/failover/cluster/:name/taint", handleAddEvictionTaints)
+	r.POST("/failover/cluster/:name/untaint", handleRemoveEvictionTaints)
+}
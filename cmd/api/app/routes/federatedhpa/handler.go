@@ -0,0 +1,130 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package federatedhpa
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	autoscalingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/autoscaling/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/resource/federatedhpa"
+)
+
+var federatedHPAKind = autoscalingv1alpha1.SchemeGroupVersion.WithKind(autoscalingv1alpha1.FederatedHPAKind).GroupKind()
+
+func handleGetFederatedHPAList(c *gin.Context) {
+	karmadaClient := client.InClusterKarmadaClient()
+	dataSelect := common.ParseDataSelectPathParameter(c)
+	nsQuery := common.ParseNamespacePathParameter(c)
+	result, err := federatedhpa.GetFederatedHPAList(karmadaClient, nsQuery, dataSelect)
+	if err != nil {
+		klog.ErrorS(err, "Failed to GetFederatedHPAList")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleGetFederatedHPADetail(c *gin.Context) {
+	karmadaClient := client.InClusterKarmadaClient()
+	namespace := c.Param("namespace")
+	name := c.Param("name")
+	result, err := federatedhpa.GetFederatedHPADetail(karmadaClient, namespace, name)
+	if err != nil {
+		klog.ErrorS(err, "GetFederatedHPADetail failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handlePostFederatedHPA(c *gin.Context) {
+	ctx := context.Context(c)
+	federatedHPARequest := new(v1.FederatedHPARequest)
+	if err := c.ShouldBind(federatedHPARequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if errs := federatedhpa.ValidateFederatedHPA(federatedHPARequest.Namespace, federatedHPARequest.Name, &federatedHPARequest.Spec); len(errs) != 0 {
+		common.Fail(c, k8serrors.NewInvalid(federatedHPAKind, federatedHPARequest.Name, errs))
+		return
+	}
+	karmadaClient := client.InClusterKarmadaClient()
+	_, err := karmadaClient.AutoscalingV1alpha1().FederatedHPAs(federatedHPARequest.Namespace).Create(ctx, &autoscalingv1alpha1.FederatedHPA{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: federatedHPARequest.Namespace,
+			Name:      federatedHPARequest.Name,
+		},
+		Spec: federatedHPARequest.Spec,
+	}, metav1.CreateOptions{})
+	if err != nil {
+		klog.ErrorS(err, "Failed to create FederatedHPA")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func handlePutFederatedHPA(c *gin.Context) {
+	ctx := context.Context(c)
+	federatedHPARequest := new(v1.FederatedHPARequest)
+	if err := c.ShouldBind(federatedHPARequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if federatedHPARequest.ResourceVersion == "" {
+		common.Fail(c, k8serrors.NewInvalid(federatedHPAKind, federatedHPARequest.Name, field.ErrorList{
+			field.Required(field.NewPath("metadata", "resourceVersion"), "the resourceVersion the FederatedHPA was read at is required"),
+		}))
+		return
+	}
+	if errs := federatedhpa.ValidateFederatedHPA(federatedHPARequest.Namespace, federatedHPARequest.Name, &federatedHPARequest.Spec); len(errs) != 0 {
+		common.Fail(c, k8serrors.NewInvalid(federatedHPAKind, federatedHPARequest.Name, errs))
+		return
+	}
+	karmadaClient := client.InClusterKarmadaClient()
+	federatedHPA, err := karmadaClient.AutoscalingV1alpha1().FederatedHPAs(federatedHPARequest.Namespace).Get(ctx, federatedHPARequest.Name, metav1.GetOptions{})
+	if err == nil {
+		federatedHPA.ResourceVersion = federatedHPARequest.ResourceVersion
+		federatedHPA.Spec = federatedHPARequest.Spec
+		_, err = karmadaClient.AutoscalingV1alpha1().FederatedHPAs(federatedHPARequest.Namespace).Update(ctx, federatedHPA, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		klog.ErrorS(err, "Failed to update FederatedHPA")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func init() {
+	r := router.V1()
+	r.GET("/federatedhpa", handleGetFederatedHPAList)
+	r.GET("/federatedhpa/:namespace", handleGetFederatedHPAList)
+	r.GET("/federatedhpa/:namespace/:name", handleGetFederatedHPADetail)
+	r.POST("/federatedhpa", handlePostFederatedHPA)
+	r.PUT("/federatedhpa", handlePutFederatedHPA)
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package federatedresourcequota
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/resource/federatedresourcequota"
+)
+
+var federatedResourceQuotaKind = v1alpha1.SchemeGroupVersion.WithKind("FederatedResourceQuota").GroupKind()
+
+func handleGetFederatedResourceQuotaList(c *gin.Context) {
+	karmadaClient := client.InClusterKarmadaClient()
+	dataSelect := common.ParseDataSelectPathParameter(c)
+	nsQuery := common.ParseNamespacePathParameter(c)
+	result, err := federatedresourcequota.GetFederatedResourceQuotaList(karmadaClient, nsQuery, dataSelect)
+	if err != nil {
+		klog.ErrorS(err, "Failed to GetFederatedResourceQuotaList")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleGetFederatedResourceQuotaDetail(c *gin.Context) {
+	karmadaClient := client.InClusterKarmadaClient()
+	namespace := c.Param("namespace")
+	name := c.Param("name")
+	result, err := federatedresourcequota.GetFederatedResourceQuotaDetail(karmadaClient, namespace, name)
+	if err != nil {
+		klog.ErrorS(err, "GetFederatedResourceQuotaDetail failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handlePostFederatedResourceQuota(c *gin.Context) {
+	ctx := context.Context(c)
+	quotaRequest := new(v1.PostFederatedResourceQuotaRequest)
+	if err := c.ShouldBind(quotaRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	quota := &v1alpha1.FederatedResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: quotaRequest.Namespace,
+			Name:      quotaRequest.Name,
+		},
+		Spec: v1alpha1.FederatedResourceQuotaSpec{
+			Overall:           quotaRequest.Overall,
+			StaticAssignments: quotaRequest.StaticAssignments,
+		},
+	}
+	if errs := federatedresourcequota.ValidateFederatedResourceQuotaSpec(&quota.Spec); len(errs) != 0 {
+		common.Fail(c, k8serrors.NewInvalid(federatedResourceQuotaKind, quota.Name, errs))
+		return
+	}
+	karmadaClient := client.InClusterKarmadaClient()
+	_, err := karmadaClient.PolicyV1alpha1().FederatedResourceQuotas(quota.Namespace).Create(ctx, quota, metav1.CreateOptions{})
+	if err != nil {
+		klog.ErrorS(err, "Failed to create FederatedResourceQuota")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func handlePutFederatedResourceQuota(c *gin.Context) {
+	ctx := context.Context(c)
+	quotaRequest := new(v1.PutFederatedResourceQuotaRequest)
+	if err := c.ShouldBind(quotaRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	spec := v1alpha1.FederatedResourceQuotaSpec{
+		Overall:           quotaRequest.Overall,
+		StaticAssignments: quotaRequest.StaticAssignments,
+	}
+	if errs := federatedresourcequota.ValidateFederatedResourceQuotaSpec(&spec); len(errs) != 0 {
+		common.Fail(c, k8serrors.NewInvalid(federatedResourceQuotaKind, quotaRequest.Name, errs))
+		return
+	}
+	karmadaClient := client.InClusterKarmadaClient()
+	quota, err := karmadaClient.PolicyV1alpha1().FederatedResourceQuotas(quotaRequest.Namespace).Get(ctx, quotaRequest.Name, metav1.GetOptions{})
+	if err == nil {
+		quota.ResourceVersion = quotaRequest.ResourceVersion
+		quota.Spec = spec
+		quota, err = karmadaClient.PolicyV1alpha1().FederatedResourceQuotas(quotaRequest.Namespace).Update(ctx, quota, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		klog.ErrorS(err, "Failed to update FederatedResourceQuota")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, v1.PolicyVersionResponse{ResourceVersion: quota.ResourceVersion})
+}
+
+func handleDeleteFederatedResourceQuota(c *gin.Context) {
+	ctx := context.Context(c)
+	namespace := c.Param("namespace")
+	name := c.Param("name")
+	karmadaClient := client.InClusterKarmadaClient()
+	err := karmadaClient.PolicyV1alpha1().FederatedResourceQuotas(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		klog.ErrorS(err, "Failed to delete FederatedResourceQuota")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func init() {
+	r := router.V1()
+	r.GET("/federatedresourcequota", handleGetFederatedResourceQuotaList)
+	r.GET("/federatedresourcequota/:namespace", handleGetFederatedResourceQuotaList)
+	r.GET("/federatedresourcequota/:namespace/:name", handleGetFederatedResourceQuotaDetail)
+	r.POST("/federatedresourcequota", handlePostFederatedResourceQuota)
+	r.PUT("/federatedresourcequota", handlePutFederatedResourceQuota)
+	r.DELETE("/federatedresourcequota/:namespace/:name", handleDeleteFederatedResourceQuota)
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/resource/pod"
+)
+
+// handleGetWorkloadLogs merges the logs of all the pods of a propagated workload across the member
+// clusters, every line is prefixed with the cluster, pod and container it comes from.
+func handleGetWorkloadLogs(c *gin.Context) {
+	req := new(v1.GetLogsRequest)
+	if err := c.ShouldBindQuery(req); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	kind, namespace, name := types.ResourceKind(c.Param("kind")), c.Param("namespace"), c.Param("name")
+	clientFor := func(cluster string) kubernetes.Interface {
+		return client.InClusterClientForMemberCluster(cluster)
+	}
+
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	sources, err := pod.GetWorkloadLogSources(ctx, client.InClusterKarmadaClient(), clientFor, kind, namespace, name, req.Container)
+	if err == nil && len(sources) > pod.MaxMergedLogStreams {
+		err = errors.NewBadRequest(fmt.Sprintf("%s %s/%s has %d containers, logs of at most %d containers can be merged, select a container",
+			kind, namespace, name, len(sources), pod.MaxMergedLogStreams))
+	}
+	if err != nil {
+		cancel()
+		klog.ErrorS(err, "Failed to get workload log sources", "kind", kind, "namespace", namespace, "name", name)
+		common.Fail(c, err)
+		return
+	}
+
+	lines := make(chan pod.LogLine)
+	go pod.MergeLogs(ctx, sources, clientFor, pod.LogOptions(*req), lines)
+	common.StreamLines(c, cancel, lines, func(line pod.LogLine) string { return line.Source.Prefix() + line.Content })
+}
+
+func init() {
+	r := router.V1()
+	r.GET("/log/:kind/:namespace/:name", handleGetWorkloadLogs)
+}
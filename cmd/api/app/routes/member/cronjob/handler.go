@@ -0,0 +1,99 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cronjob
+
+import (
+	"github.com/gin-gonic/gin"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/resource/cronjob"
+)
+
+func handleGetMemberCronJobJobs(c *gin.Context) {
+	req := new(v1.GetCronJobJobsRequest)
+	if err := c.ShouldBindQuery(req); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	namespace, name := c.Param("namespace"), c.Param("name")
+	dataSelect := common.ParseDataSelectPathParameter(c)
+	memberClient := client.InClusterClientForMemberCluster(c.Param("clustername"))
+	result, err := cronjob.GetCronJobJobs(memberClient, dataSelect, namespace, name, req.Active)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleTriggerMemberCronJob(c *gin.Context) {
+	namespace, name := c.Param("namespace"), c.Param("name")
+	memberClient := client.InClusterClientForMemberCluster(c.Param("clustername"))
+	if err := cronjob.TriggerCronJob(memberClient, namespace, name); err != nil {
+		klog.ErrorS(err, "Failed to trigger member cronjob", "cluster", c.Param("clustername"), "namespace", namespace, "name", name)
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+// handleSuspendMemberCronJob suspends or resumes a cron job directly in a member cluster, karmada will
+// revert it on the next sync of the resource template, suspend the cron job of the control plane instead.
+func handleSuspendMemberCronJob(suspend bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		namespace, name := c.Param("namespace"), c.Param("name")
+		memberClient := client.InClusterClientForMemberCluster(c.Param("clustername"))
+		if err := cronjob.SuspendCronJob(memberClient, namespace, name, suspend); err != nil {
+			klog.ErrorS(err, "Failed to suspend member cronjob", "cluster", c.Param("clustername"), "namespace", namespace, "name", name, "suspend", suspend)
+			common.Fail(c, err)
+			return
+		}
+		common.Success(c, "ok")
+	}
+}
+
+func handleGetMemberCronJobSchedule(c *gin.Context) {
+	req := new(v1.GetCronJobScheduleRequest)
+	if err := c.ShouldBindQuery(req); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if req.Count == 0 {
+		req.Count = cronjob.DefaultNextRuns
+	}
+	namespace, name := c.Param("namespace"), c.Param("name")
+	memberClient := client.InClusterClientForMemberCluster(c.Param("clustername"))
+	result, err := cronjob.GetCronJobSchedule(memberClient, namespace, name, req.Count)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func init() {
+	r := router.MemberV1()
+	r.GET("/cronjob/:namespace/:name/job", handleGetMemberCronJobJobs)
+	r.GET("/cronjob/:namespace/:name/schedule", handleGetMemberCronJobSchedule)
+	r.POST("/cronjob/:namespace/:name/trigger", handleTriggerMemberCronJob)
+	r.POST("/cronjob/:namespace/:name/suspend", handleSuspendMemberCronJob(true))
+	r.POST("/cronjob/:namespace/:name/resume", handleSuspendMemberCronJob(false))
+}
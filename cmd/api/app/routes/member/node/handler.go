@@ -17,9 +17,13 @@ limitations under the License.
 package node
 
 import (
+	"time"
+
 	"github.com/gin-gonic/gin"
+	"k8s.io/klog/v2"
 
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
 	"github.com/karmada-io/dashboard/pkg/client"
 	"github.com/karmada-io/dashboard/pkg/resource/node"
@@ -36,7 +40,68 @@ func handleGetClusterNode(c *gin.Context) {
 	common.Success(c, result)
 }
 
+func handleGetClusterNodeDetail(c *gin.Context) {
+	memberClient := client.InClusterClientForMemberCluster(c.Param("clustername"))
+	result, err := node.GetNodeDetail(memberClient, c.Param("name"))
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleCordonClusterNode(c *gin.Context) {
+	memberClient := client.InClusterClientForMemberCluster(c.Param("clustername"))
+	if err := node.CordonNode(memberClient, c.Param("name"), true); err != nil {
+		klog.ErrorS(err, "Failed to cordon node", "cluster", c.Param("clustername"), "node", c.Param("name"))
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func handleUncordonClusterNode(c *gin.Context) {
+	memberClient := client.InClusterClientForMemberCluster(c.Param("clustername"))
+	if err := node.CordonNode(memberClient, c.Param("name"), false); err != nil {
+		klog.ErrorS(err, "Failed to uncordon node", "cluster", c.Param("clustername"), "node", c.Param("name"))
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func handleDrainClusterNode(c *gin.Context) {
+	req := new(v1.DrainNodeRequest)
+	if err := c.ShouldBind(req); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	opts := node.DrainOptions{
+		Force:              req.Force,
+		IgnoreDaemonSets:   req.IgnoreDaemonSets,
+		DeleteEmptyDirData: req.DeleteEmptyDirData,
+		GracePeriodSeconds: -1,
+		Timeout:            time.Duration(req.TimeoutSeconds) * time.Second,
+		DryRun:             req.DryRun,
+	}
+	if req.GracePeriodSeconds != nil {
+		opts.GracePeriodSeconds = *req.GracePeriodSeconds
+	}
+	memberClient := client.InClusterClientForMemberCluster(c.Param("clustername"))
+	result, err := node.DrainNode(memberClient, c.Param("name"), opts)
+	if err != nil {
+		klog.ErrorS(err, "Failed to drain node", "cluster", c.Param("clustername"), "node", c.Param("name"))
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
 func init() {
 	r := router.MemberV1()
 	r.GET("/node", handleGetClusterNode)
+	r.GET("/node/:name", handleGetClusterNodeDetail)
+	r.POST("/node/:name/cordon", handleCordonClusterNode)
+	r.POST("/node/:name/uncordon", handleUncordonClusterNode)
+	r.POST("/node/:name/drain", handleDrainClusterNode)
 }
@@ -17,43 +17,127 @@ limitations under the License.
 package pod
 
 import (
+	"context"
+
 	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
 
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
 	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/common/errors"
 	"github.com/karmada-io/dashboard/pkg/resource/pod"
+	"github.com/karmada-io/dashboard/pkg/terminal"
 )
 
-// return a pods list
-func handleGetMemberPod(c *gin.Context) {
-	memberClient := client.InClusterClientForMemberCluster(c.Param("clustername"))
-	dataSelect := common.ParseDataSelectPathParameter(c)
-	nsQuery := common.ParseNamespacePathParameter(c)
-	result, err := pod.GetPodList(memberClient, nsQuery, dataSelect)
+func handleGetMemberPodLogs(c *gin.Context) {
+	req := new(v1.GetLogsRequest)
+	if err := c.ShouldBindQuery(req); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	clusterName, namespace, name := c.Param("clustername"), c.Param("namespace"), c.Param("name")
+	memberClient := client.InClusterClientForMemberCluster(clusterName)
+	opts := pod.LogOptions(*req)
+
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	stream, err := pod.StreamPodLogs(ctx, memberClient, namespace, name, opts)
 	if err != nil {
+		cancel()
+		klog.ErrorS(err, "Failed to stream pod logs", "cluster", clusterName, "namespace", namespace, "pod", name)
 		common.Fail(c, err)
 		return
 	}
-	common.Success(c, result)
+
+	lines := make(chan pod.LogLine)
+	go func() {
+		defer close(lines)
+		source := pod.LogSource{Cluster: clusterName, Namespace: namespace, Pod: name, Container: opts.Container}
+		if err := pod.ReadLogLines(ctx, source, stream, lines); err != nil && ctx.Err() == nil {
+			klog.ErrorS(err, "Failed to read pod logs", "cluster", clusterName, "namespace", namespace, "pod", name)
+		}
+	}()
+	common.StreamLines(c, cancel, lines, func(line pod.LogLine) string { return line.Content })
 }
 
-// return a pod detail
-func handleGetMemberPodDetail(c *gin.Context) {
-	memberClient := client.InClusterClientForMemberCluster(c.Param("clustername"))
-	namespace := c.Param("namespace")
-	name := c.Param("name")
-	result, err := pod.GetPodDetail(memberClient, namespace, name)
+// handleExecMemberPod bridges a websocket to a TTY exec into a container of the pod, the user must be
+// allowed to create pods/exec in the member cluster.
+func handleExecMemberPod(c *gin.Context) {
+	if !websocket.IsWebSocketUpgrade(c.Request) {
+		common.Fail(c, errors.NewBadRequest("exec requires a websocket connection"))
+		return
+	}
+	req := new(v1.ExecPodRequest)
+	if err := c.ShouldBindQuery(req); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	clusterName, namespace, name := c.Param("clustername"), c.Param("namespace"), c.Param("name")
+
+	userClient, err := client.GetMemberClientFromRequest(c.Request, clusterName)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if err := terminal.CanExec(c.Request.Context(), userClient, namespace, name); err != nil {
+		klog.ErrorS(err, "Exec into pod is not allowed", "cluster", clusterName, "namespace", namespace, "pod", name)
+		common.Fail(c, err)
+		return
+	}
+
+	memberClient := client.InClusterClientForMemberCluster(clusterName)
+	memberConfig, err := client.GetMemberConfigForCluster(clusterName)
 	if err != nil {
 		common.Fail(c, err)
 		return
 	}
-	common.Success(c, result)
+	container := req.Container
+	if container == "" {
+		target, err := memberClient.CoreV1().Pods(namespace).Get(c.Request.Context(), name, metav1.GetOptions{})
+		if err != nil {
+			common.Fail(c, err)
+			return
+		}
+		container = pod.DefaultContainer(target)
+	}
+
+	conn, err := common.UpgradeWebSocket(c)
+	if err != nil {
+		klog.ErrorS(err, "Failed to upgrade to websocket")
+		return
+	}
+	session, err := terminal.NewSession(conn, terminal.SessionInfo{
+		Cluster:   clusterName,
+		Namespace: namespace,
+		Pod:       name,
+		Container: container,
+		Command:   req.Command,
+	}, terminal.DefaultIdleTimeout)
+	if err != nil {
+		klog.ErrorS(err, "Failed to create terminal session")
+		_ = conn.Close()
+		return
+	}
+	defer session.Close()
+
+	// the request context is not cancelled once the connection is hijacked, the session tells when it ends
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-session.Done()
+		cancel()
+	}()
+	if err := terminal.Exec(ctx, memberConfig, memberClient, session); err != nil && ctx.Err() == nil {
+		klog.ErrorS(err, "Failed to exec into pod", "cluster", clusterName, "namespace", namespace, "pod", name, "container", container)
+		session.Toast(err.Error())
+	}
 }
 
 func init() {
 	r := router.MemberV1()
-	r.GET("/pod", handleGetMemberPod)
-	r.GET("/pod/:namespace", handleGetMemberPod)
-	r.GET("/pod/:namespace/:name", handleGetMemberPodDetail)
+	r.GET("/pod/:namespace/:name/log", handleGetMemberPodLogs)
+	r.GET("/pod/:namespace/:name/exec", handleExecMemberPod)
 }
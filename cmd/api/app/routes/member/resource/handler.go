@@ -0,0 +1,136 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"github.com/gin-gonic/gin"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	resourcecommon "github.com/karmada-io/dashboard/pkg/resource/common"
+	"github.com/karmada-io/dashboard/pkg/resource/configmap"
+	"github.com/karmada-io/dashboard/pkg/resource/cronjob"
+	"github.com/karmada-io/dashboard/pkg/resource/daemonset"
+	"github.com/karmada-io/dashboard/pkg/resource/deployment"
+	"github.com/karmada-io/dashboard/pkg/resource/event"
+	"github.com/karmada-io/dashboard/pkg/resource/ingress"
+	"github.com/karmada-io/dashboard/pkg/resource/job"
+	"github.com/karmada-io/dashboard/pkg/resource/pod"
+	"github.com/karmada-io/dashboard/pkg/resource/secret"
+	"github.com/karmada-io/dashboard/pkg/resource/service"
+	"github.com/karmada-io/dashboard/pkg/resource/statefulset"
+)
+
+type listFunc func(client kubernetes.Interface, nsQuery *resourcecommon.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (interface{}, error)
+
+type detailFunc func(client kubernetes.Interface, namespace, name string) (interface{}, error)
+
+type eventsFunc func(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery, namespace, name string) (*resourcecommon.EventList, error)
+
+// memberResource describes how a namespaced kind of pkg/resource is browsed in a member cluster.
+type memberResource struct {
+	kind   types.ResourceKind
+	list   listFunc
+	detail detailFunc
+	events eventsFunc
+}
+
+func asList[T any](f func(kubernetes.Interface, *resourcecommon.NamespaceQuery, *dataselect.DataSelectQuery) (T, error)) listFunc {
+	return func(client kubernetes.Interface, nsQuery *resourcecommon.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (interface{}, error) {
+		return f(client, nsQuery, dsQuery)
+	}
+}
+
+func asDetail[T any](f func(kubernetes.Interface, string, string) (T, error)) detailFunc {
+	return func(client kubernetes.Interface, namespace, name string) (interface{}, error) {
+		return f(client, namespace, name)
+	}
+}
+
+// memberResources is the table of the kinds exposed under /member/:clustername, each kind gets
+// list, detail and event routes. Kinds with extra actions or that are not namespaced, such as
+// node and namespace, register their own routes.
+var memberResources = []memberResource{
+	{kind: types.ResourceKindDeployment, list: asList(deployment.GetDeploymentList), detail: asDetail(deployment.GetDeploymentDetail)},
+	{kind: types.ResourceKindStatefulSet, list: asList(statefulset.GetStatefulSetList), detail: asDetail(statefulset.GetStatefulSetDetail)},
+	{kind: types.ResourceKindDaemonSet, list: asList(daemonset.GetDaemonSetList), detail: asDetail(daemonset.GetDaemonSetDetail)},
+	{kind: types.ResourceKindJob, list: asList(job.GetJobList), detail: asDetail(job.GetJobDetail), events: job.GetJobEvents},
+	{kind: types.ResourceKindCronJob, list: asList(cronjob.GetCronJobList), detail: asDetail(cronjob.GetCronJobDetail), events: cronjob.GetCronJobEvents},
+	{kind: types.ResourceKindService, list: asList(service.GetServiceList), detail: asDetail(service.GetServiceDetail), events: service.GetServiceEvents},
+	{kind: types.ResourceKindIngress, list: asList(ingress.GetIngressList), detail: asDetail(ingress.GetIngressDetail)},
+	{kind: types.ResourceKindConfigMap, list: asList(configmap.GetConfigMapList), detail: asDetail(configmap.GetConfigMapDetail)},
+	{kind: types.ResourceKindSecret, list: asList(secret.GetSecretList), detail: asDetail(secret.GetSecretDetail)},
+	{kind: types.ResourceKindPod, list: asList(pod.GetPodList), detail: asDetail(pod.GetPodDetail)},
+}
+
+func handleGetMemberResourceList(resource memberResource) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		memberClient := client.InClusterClientForMemberCluster(c.Param("clustername"))
+		nsQuery := common.ParseNamespacePathParameter(c)
+		dataSelect := common.ParseDataSelectPathParameter(c)
+		result, err := resource.list(memberClient, nsQuery, dataSelect)
+		if err != nil {
+			common.Fail(c, err)
+			return
+		}
+		common.Success(c, result)
+	}
+}
+
+func handleGetMemberResourceDetail(resource memberResource) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		memberClient := client.InClusterClientForMemberCluster(c.Param("clustername"))
+		result, err := resource.detail(memberClient, c.Param("namespace"), c.Param("name"))
+		if err != nil {
+			common.Fail(c, err)
+			return
+		}
+		common.Success(c, result)
+	}
+}
+
+func handleGetMemberResourceEvents(resource memberResource) gin.HandlerFunc {
+	events := resource.events
+	if events == nil {
+		events = event.GetResourceEvents
+	}
+	return func(c *gin.Context) {
+		memberClient := client.InClusterClientForMemberCluster(c.Param("clustername"))
+		dataSelect := common.ParseDataSelectPathParameter(c)
+		result, err := events(memberClient, dataSelect, c.Param("namespace"), c.Param("name"))
+		if err != nil {
+			common.Fail(c, err)
+			return
+		}
+		common.Success(c, result)
+	}
+}
+
+func init() {
+	r := router.MemberV1()
+	for _, resource := range memberResources {
+		path := "/" + string(resource.kind)
+		r.GET(path, handleGetMemberResourceList(resource))
+		r.GET(path+"/:namespace", handleGetMemberResourceList(resource))
+		r.GET(path+"/:namespace/:name", handleGetMemberResourceDetail(resource))
+		r.GET(path+"/:namespace/:name/event", handleGetMemberResourceEvents(resource))
+	}
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"github.com/gin-gonic/gin"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/resource/workload"
+)
+
+// handleScaleMemberWorkload scales a workload directly in a member cluster, karmada will revert it on
+// the next sync of the resource template unless the replicas are retained, it's meant for emergencies.
+func handleScaleMemberWorkload(c *gin.Context) {
+	req := new(v1.ScaleWorkloadRequest)
+	if err := c.ShouldBind(req); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	kind, namespace, name := types.ResourceKind(c.Param("kind")), c.Param("namespace"), c.Param("name")
+	memberClient := client.InClusterClientForMemberCluster(c.Param("clustername"))
+	result, err := workload.Scale(memberClient, nil, kind, namespace, name, *req.Replicas, req.DryRun)
+	if err != nil {
+		klog.ErrorS(err, "Failed to scale member workload", "cluster", c.Param("clustername"), "kind", kind, "namespace", namespace, "name", name)
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleRestartMemberWorkload(c *gin.Context) {
+	kind, namespace, name := types.ResourceKind(c.Param("kind")), c.Param("namespace"), c.Param("name")
+	memberClient := client.InClusterClientForMemberCluster(c.Param("clustername"))
+	if err := workload.Restart(memberClient, kind, namespace, name); err != nil {
+		klog.ErrorS(err, "Failed to restart member workload", "cluster", c.Param("clustername"), "kind", kind, "namespace", namespace, "name", name)
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func init() {
+	r := router.MemberV1()
+	r.POST("/workload/:kind/:namespace/:name/scale", handleScaleMemberWorkload)
+	r.POST("/workload/:kind/:namespace/:name/restart", handleRestartMemberWorkload)
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiclusteringress
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	networkingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/networking/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/resource/multiclusteringress"
+)
+
+var multiClusterIngressKind = networkingv1alpha1.SchemeGroupVersion.WithKind(networkingv1alpha1.ResourceKindMultiClusterIngress).GroupKind()
+
+func handleGetMultiClusterIngressList(c *gin.Context) {
+	karmadaClient := client.InClusterKarmadaClient()
+	dataSelect := common.ParseDataSelectPathParameter(c)
+	nsQuery := common.ParseNamespacePathParameter(c)
+	result, err := multiclusteringress.GetMultiClusterIngressList(karmadaClient, nsQuery, dataSelect)
+	if err != nil {
+		klog.ErrorS(err, "Failed to GetMultiClusterIngressList")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleGetMultiClusterIngressDetail(c *gin.Context) {
+	karmadaClient := client.InClusterKarmadaClient()
+	namespace := c.Param("namespace")
+	name := c.Param("name")
+	result, err := multiclusteringress.GetMultiClusterIngressDetail(karmadaClient, namespace, name)
+	if err != nil {
+		klog.ErrorS(err, "GetMultiClusterIngressDetail failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handlePostMultiClusterIngress(c *gin.Context) {
+	ctx := context.Context(c)
+	multiClusterIngressRequest := new(v1.MultiClusterIngressRequest)
+	if err := c.ShouldBind(multiClusterIngressRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	karmadaClient := client.InClusterKarmadaClient()
+	_, err := karmadaClient.NetworkingV1alpha1().MultiClusterIngresses(multiClusterIngressRequest.Namespace).Create(ctx, &networkingv1alpha1.MultiClusterIngress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: multiClusterIngressRequest.Namespace,
+			Name:      multiClusterIngressRequest.Name,
+		},
+		Spec: multiClusterIngressRequest.Spec,
+	}, metav1.CreateOptions{})
+	if err != nil {
+		klog.ErrorS(err, "Failed to create MultiClusterIngress")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func handlePutMultiClusterIngress(c *gin.Context) {
+	ctx := context.Context(c)
+	multiClusterIngressRequest := new(v1.MultiClusterIngressRequest)
+	if err := c.ShouldBind(multiClusterIngressRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if multiClusterIngressRequest.ResourceVersion == "" {
+		common.Fail(c, k8serrors.NewInvalid(multiClusterIngressKind, multiClusterIngressRequest.Name, field.ErrorList{
+			field.Required(field.NewPath("metadata", "resourceVersion"), "the resourceVersion the MultiClusterIngress was read at is required"),
+		}))
+		return
+	}
+	karmadaClient := client.InClusterKarmadaClient()
+	multiClusterIngress, err := karmadaClient.NetworkingV1alpha1().MultiClusterIngresses(multiClusterIngressRequest.Namespace).Get(ctx, multiClusterIngressRequest.Name, metav1.GetOptions{})
+	if err == nil {
+		multiClusterIngress.ResourceVersion = multiClusterIngressRequest.ResourceVersion
+		multiClusterIngress.Spec = multiClusterIngressRequest.Spec
+		_, err = karmadaClient.NetworkingV1alpha1().MultiClusterIngresses(multiClusterIngressRequest.Namespace).Update(ctx, multiClusterIngress, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		klog.ErrorS(err, "Failed to update MultiClusterIngress")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func handleDeleteMultiClusterIngress(c *gin.Context) {
+	ctx := context.Context(c)
+	namespace := c.Param("namespace")
+	name := c.Param("name")
+	karmadaClient := client.InClusterKarmadaClient()
+	err := karmadaClient.NetworkingV1alpha1().MultiClusterIngresses(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		klog.ErrorS(err, "Failed to delete MultiClusterIngress")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func init() {
+	r := router.V1()
+	r.GET("/multiclusteringress", handleGetMultiClusterIngressList)
+	r.GET("/multiclusteringress/:namespace", handleGetMultiClusterIngressList)
+	r.GET("/multiclusteringress/:namespace/:name", handleGetMultiClusterIngressDetail)
+	r.POST("/multiclusteringress", handlePostMultiClusterIngress)
+	r.PUT("/multiclusteringress", handlePutMultiClusterIngress)
+	r.DELETE("/multiclusteringress/:namespace/:name", handleDeleteMultiClusterIngress)
+}
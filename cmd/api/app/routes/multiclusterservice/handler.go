@@ -0,0 +1,137 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiclusterservice
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	networkingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/networking/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/resource/multiclusterservice"
+)
+
+var multiClusterServiceKind = networkingv1alpha1.SchemeGroupVersion.WithKind(networkingv1alpha1.ResourceKindMultiClusterService).GroupKind()
+
+func handleGetMultiClusterServiceList(c *gin.Context) {
+	karmadaClient := client.InClusterKarmadaClient()
+	dataSelect := common.ParseDataSelectPathParameter(c)
+	nsQuery := common.ParseNamespacePathParameter(c)
+	result, err := multiclusterservice.GetMultiClusterServiceList(karmadaClient, nsQuery, dataSelect)
+	if err != nil {
+		klog.ErrorS(err, "Failed to GetMultiClusterServiceList")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleGetMultiClusterServiceDetail(c *gin.Context) {
+	karmadaClient := client.InClusterKarmadaClient()
+	namespace := c.Param("namespace")
+	name := c.Param("name")
+	result, err := multiclusterservice.GetMultiClusterServiceDetail(karmadaClient, namespace, name)
+	if err != nil {
+		klog.ErrorS(err, "GetMultiClusterServiceDetail failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handlePostMultiClusterService(c *gin.Context) {
+	ctx := context.Context(c)
+	multiClusterServiceRequest := new(v1.MultiClusterServiceRequest)
+	if err := c.ShouldBind(multiClusterServiceRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	karmadaClient := client.InClusterKarmadaClient()
+	_, err := karmadaClient.NetworkingV1alpha1().MultiClusterServices(multiClusterServiceRequest.Namespace).Create(ctx, &networkingv1alpha1.MultiClusterService{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: multiClusterServiceRequest.Namespace,
+			Name:      multiClusterServiceRequest.Name,
+		},
+		Spec: multiClusterServiceRequest.Spec,
+	}, metav1.CreateOptions{})
+	if err != nil {
+		klog.ErrorS(err, "Failed to create MultiClusterService")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func handlePutMultiClusterService(c *gin.Context) {
+	ctx := context.Context(c)
+	multiClusterServiceRequest := new(v1.MultiClusterServiceRequest)
+	if err := c.ShouldBind(multiClusterServiceRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if multiClusterServiceRequest.ResourceVersion == "" {
+		common.Fail(c, k8serrors.NewInvalid(multiClusterServiceKind, multiClusterServiceRequest.Name, field.ErrorList{
+			field.Required(field.NewPath("metadata", "resourceVersion"), "the resourceVersion the MultiClusterService was read at is required"),
+		}))
+		return
+	}
+	karmadaClient := client.InClusterKarmadaClient()
+	multiClusterService, err := karmadaClient.NetworkingV1alpha1().MultiClusterServices(multiClusterServiceRequest.Namespace).Get(ctx, multiClusterServiceRequest.Name, metav1.GetOptions{})
+	if err == nil {
+		multiClusterService.ResourceVersion = multiClusterServiceRequest.ResourceVersion
+		multiClusterService.Spec = multiClusterServiceRequest.Spec
+		_, err = karmadaClient.NetworkingV1alpha1().MultiClusterServices(multiClusterServiceRequest.Namespace).Update(ctx, multiClusterService, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		klog.ErrorS(err, "Failed to update MultiClusterService")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func handleDeleteMultiClusterService(c *gin.Context) {
+	ctx := context.Context(c)
+	namespace := c.Param("namespace")
+	name := c.Param("name")
+	karmadaClient := client.InClusterKarmadaClient()
+	err := karmadaClient.NetworkingV1alpha1().MultiClusterServices(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		klog.ErrorS(err, "Failed to delete MultiClusterService")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func init() {
+	r := router.V1()
+	r.GET("/multiclusterservice", handleGetMultiClusterServiceList)
+	r.GET("/multiclusterservice/:namespace", handleGetMultiClusterServiceList)
+	r.GET("/multiclusterservice/:namespace/:name", handleGetMultiClusterServiceDetail)
+	r.POST("/multiclusterservice", handlePostMultiClusterService)
+	r.PUT("/multiclusterservice", handlePutMultiClusterService)
+	r.DELETE("/multiclusterservice/:namespace/:name", handleDeleteMultiClusterService)
+}
@@ -18,12 +18,16 @@ package namespace
 
 import (
 	"github.com/gin-gonic/gin"
+	"k8s.io/klog/v2"
 
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
 	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
 	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	resourcecommon "github.com/karmada-io/dashboard/pkg/resource/common"
 	"github.com/karmada-io/dashboard/pkg/resource/event"
+	"github.com/karmada-io/dashboard/pkg/resource/federatedresourcequota"
 	ns "github.com/karmada-io/dashboard/pkg/resource/namespace"
 )
 
@@ -62,6 +66,14 @@ func handleGetNamespaceDetail(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	quotas, err := federatedresourcequota.GetFederatedResourceQuotaList(client.InClusterKarmadaClient(),
+		resourcecommon.NewNamespaceQuery([]string{name}), dataselect.NoDataSelect)
+	if err != nil {
+		klog.ErrorS(err, "Failed to get federated resource quotas of namespace", "namespace", name)
+	} else {
+		result.QuotaUsage = quotas.Items
+		result.Errors = append(result.Errors, quotas.Errors...)
+	}
 	common.Success(c, result)
 }
 func handleGetNamespaceEvents(c *gin.Context) {
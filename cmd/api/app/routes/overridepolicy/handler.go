@@ -18,13 +18,18 @@ package overridepolicy
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/client-go/util/retry"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/klog/v2"
-	"sigs.k8s.io/yaml"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
 	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
@@ -32,9 +37,20 @@ import (
 	"github.com/karmada-io/dashboard/pkg/client"
 	"github.com/karmada-io/dashboard/pkg/common/errors"
 	"github.com/karmada-io/dashboard/pkg/resource/overridepolicy"
+	"github.com/karmada-io/dashboard/pkg/resource/policy"
+)
+
+var (
+	overridePolicyKind        = v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.ResourceKindOverridePolicy).GroupKind()
+	clusterOverridePolicyKind = v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.ResourceKindClusterOverridePolicy).GroupKind()
 )
 
 func handleGetOverridePolicyList(c *gin.Context) {
+	listRequest := new(v1.GetPolicyListRequest)
+	if err := c.ShouldBindQuery(listRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
 	karmadaClient := client.InClusterKarmadaClient()
 	dataSelect := common.ParseDataSelectPathParameter(c)
 	namespace := common.ParseNamespacePathParameter(c)
@@ -45,6 +61,16 @@ func handleGetOverridePolicyList(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	if listRequest.Analyze {
+		if report, err := policy.GetAnalyzeReport(c, client.InClusterRuntimeClientForKarmadaAPIServer()); err != nil {
+			klog.ErrorS(err, "Failed to analyze policies")
+		} else {
+			for i := range overrideList.OverridePolicys {
+				item := &overrideList.OverridePolicys[i]
+				item.Warnings = report.Warnings(policy.OverridePolicyKey(false, item.ObjectMeta.Namespace, item.ObjectMeta.Name))
+			}
+		}
+	}
 	common.Success(c, overrideList)
 }
 func handleGetOverridePolicyDetail(c *gin.Context) {
@@ -71,27 +97,13 @@ func handlePostOverridePolicy(c *gin.Context) {
 		overridepolicyRequest.Namespace = "default"
 	}
 
-	var err error
-	karmadaClient := client.InClusterKarmadaClient()
-	if overridepolicyRequest.IsClusterScope {
-		clusteroverridePolicy := v1alpha1.ClusterOverridePolicy{}
-		if err = yaml.Unmarshal([]byte(overridepolicyRequest.OverrideData), &clusteroverridePolicy); err != nil {
-			klog.ErrorS(err, "Failed to unmarshal ClusterOverridePolicy")
-			common.Fail(c, err)
-			return
-		}
-		_, err = karmadaClient.PolicyV1alpha1().ClusterOverridePolicies().Create(ctx, &clusteroverridePolicy, metav1.CreateOptions{})
-	} else {
-		overridePolicy := v1alpha1.OverridePolicy{}
-		if err = yaml.Unmarshal([]byte(overridepolicyRequest.OverrideData), &overridePolicy); err != nil {
-			klog.ErrorS(err, "Failed to unmarshal OverridePolicy")
-			common.Fail(c, err)
-			return
-		}
-		_, err = karmadaClient.PolicyV1alpha1().OverridePolicies(overridepolicyRequest.Namespace).Create(ctx, &overridePolicy, metav1.CreateOptions{})
-	}
+	obj, err := overridepolicy.Unmarshal(overridepolicyRequest.OverrideData, overridepolicyRequest.IsClusterScope, overridepolicyRequest.Namespace)
 	if err != nil {
-		klog.ErrorS(err, "Failed to create OverridePolicies")
+		common.Fail(c, err)
+		return
+	}
+	if err = policy.Create(ctx, client.InClusterRuntimeClientForKarmadaAPIServer(), obj); err != nil {
+		klog.ErrorS(err, "Failed to create OverridePolicy")
 		common.Fail(c, err)
 		return
 	}
@@ -104,39 +116,41 @@ func handlePutOverridePolicy(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
-	var err error
-	karmadaClient := client.InClusterKarmadaClient()
-	// todo check pp exist
-	if overridepolicyRequest.IsClusterScope {
-		clusteroverridePolicy := v1alpha1.ClusterOverridePolicy{}
-		if err = yaml.Unmarshal([]byte(overridepolicyRequest.OverrideData), &clusteroverridePolicy); err != nil {
-			klog.ErrorS(err, "Failed to unmarshal ClusterOverridePolicy")
-			common.Fail(c, err)
-			return
-		}
-		_, err = karmadaClient.PolicyV1alpha1().ClusterOverridePolicies().Update(ctx, &clusteroverridePolicy, metav1.UpdateOptions{})
-	} else {
-		overridePolicy := v1alpha1.OverridePolicy{}
-		if err = yaml.Unmarshal([]byte(overridepolicyRequest.OverrideData), &overridePolicy); err != nil {
-			klog.ErrorS(err, "Failed to unmarshal OverridePolicy")
-			common.Fail(c, err)
-			return
-		}
-		var oldOverridePolicy *v1alpha1.OverridePolicy
-		oldOverridePolicy, err = karmadaClient.PolicyV1alpha1().OverridePolicies(overridepolicyRequest.Namespace).Get(ctx, overridepolicyRequest.Name, metav1.GetOptions{})
-		if err == nil {
-			// only spec can be updated
-			overridePolicy.TypeMeta = oldOverridePolicy.TypeMeta
-			overridePolicy.ObjectMeta = oldOverridePolicy.ObjectMeta
-			_, err = karmadaClient.PolicyV1alpha1().OverridePolicies(overridepolicyRequest.Namespace).Update(ctx, &overridePolicy, metav1.UpdateOptions{})
-		}
+	obj, err := overridepolicy.Unmarshal(overridepolicyRequest.OverrideData, overridepolicyRequest.IsClusterScope, overridepolicyRequest.Namespace)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	key := policy.OverridePolicyKey(overridepolicyRequest.IsClusterScope, overridepolicyRequest.Namespace, overridepolicyRequest.Name)
+	// the resourceVersion in request takes precedence over the one carried by yaml.
+	resourceVersion := overridepolicyRequest.ResourceVersion
+	if resourceVersion == "" {
+		resourceVersion = obj.GetResourceVersion()
 	}
+	updated, err := policy.UpdateSpec(ctx, client.InClusterRuntimeClientForKarmadaAPIServer(), key, resourceVersion, obj)
 	if err != nil {
 		klog.ErrorS(err, "Failed to update OverridePolicy")
 		common.Fail(c, err)
 		return
 	}
-	common.Success(c, "ok")
+	common.Success(c, v1.PolicyVersionResponse{ResourceVersion: updated.GetResourceVersion()})
+}
+func handlePutOverridePolicyMetadata(c *gin.Context) {
+	ctx := context.Context(c)
+	metadataRequest := new(v1.PutPolicyMetadataRequest)
+	if err := c.ShouldBind(metadataRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	key := policy.OverridePolicyKey(metadataRequest.IsClusterScope, metadataRequest.Namespace, metadataRequest.Name)
+	updated, err := policy.UpdateMetadata(ctx, client.InClusterRuntimeClientForKarmadaAPIServer(), key,
+		metadataRequest.ResourceVersion, metadataRequest.Labels, metadataRequest.Annotations)
+	if err != nil {
+		klog.ErrorS(err, "Failed to update metadata of OverridePolicy")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, v1.PolicyVersionResponse{ResourceVersion: updated.GetResourceVersion()})
 }
 func handleDeleteOverridePolicy(c *gin.Context) {
 	ctx := context.Context(c)
@@ -145,36 +159,138 @@ func handleDeleteOverridePolicy(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
-	var err error
-	karmadaClient := client.InClusterKarmadaClient()
-	if overridepolicyRequest.IsClusterScope {
-		err = karmadaClient.PolicyV1alpha1().ClusterOverridePolicies().Delete(ctx, overridepolicyRequest.Name, metav1.DeleteOptions{})
-		if err != nil {
-			klog.ErrorS(err, "Failed to delete ClusterOverridePolicy")
-			common.Fail(c, err)
-			return
-		}
-	} else {
-		err = karmadaClient.PolicyV1alpha1().OverridePolicies(overridepolicyRequest.Namespace).Delete(ctx, overridepolicyRequest.Name, metav1.DeleteOptions{})
-		if err != nil {
-			klog.ErrorS(err, "Failed to delete OverridePolicy")
-			common.Fail(c, err)
-			return
-		}
-		_ = retry.OnError(
-			retry.DefaultRetry,
-			func(err error) bool {
-				return errors.IsNotFound(err)
-			},
-			func() error {
-				_, getErr := karmadaClient.PolicyV1alpha1().OverridePolicies(overridepolicyRequest.Namespace).Get(ctx, overridepolicyRequest.Name, metav1.GetOptions{})
-				return getErr
-			})
+	key := policy.OverridePolicyKey(overridepolicyRequest.IsClusterScope, overridepolicyRequest.Namespace, overridepolicyRequest.Name)
+	timeout := time.Duration(overridepolicyRequest.TimeoutSeconds) * time.Second
+	if err := policy.Delete(ctx, client.InClusterRuntimeClientForKarmadaAPIServer(), key, timeout); err != nil {
+		klog.ErrorS(err, "Failed to delete OverridePolicy")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+// parseOverridePolicyForm binds the form in request, sets default values and validates it.
+func parseOverridePolicyForm(c *gin.Context) (*v1.OverridePolicyForm, field.ErrorList, error) {
+	form := new(v1.OverridePolicyForm)
+	if err := c.ShouldBind(form); err != nil {
+		return nil, nil, err
 	}
+	if form.IsClusterScope {
+		form.Namespace = ""
+	} else if form.Namespace == "" {
+		form.Namespace = "default"
+	}
+	overridepolicy.SetDefaultOverrideSpec(form.Namespace, &form.Spec)
+	return form, overridepolicy.ValidateOverridePolicy(form.Name, form.Namespace, &form.Spec), nil
+}
 
+func handleValidateOverridePolicy(c *gin.Context) {
+	form, errs, err := parseOverridePolicyForm(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, v1.ValidatePolicyResponse{
+		Valid:  len(errs) == 0,
+		Errors: errors.ToFieldErrors(errs),
+		Spec:   form.Spec,
+	})
+}
+
+func handlePostOverridePolicyForm(c *gin.Context) {
+	ctx := context.Context(c)
+	form, errs, err := parseOverridePolicyForm(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	obj, err := overridePolicyFromForm(form, errs)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if err = policy.Create(ctx, client.InClusterRuntimeClientForKarmadaAPIServer(), obj); err != nil {
+		klog.ErrorS(err, "Failed to create OverridePolicy")
+		common.Fail(c, err)
+		return
+	}
 	common.Success(c, "ok")
 }
 
+func handlePutOverridePolicyForm(c *gin.Context) {
+	ctx := context.Context(c)
+	form, errs, err := parseOverridePolicyForm(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	obj, err := overridePolicyFromForm(form, errs)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	key := policy.OverridePolicyKey(form.IsClusterScope, form.Namespace, form.Name)
+	updated, err := policy.UpdateSpec(ctx, client.InClusterRuntimeClientForKarmadaAPIServer(), key, form.ResourceVersion, obj)
+	if err != nil {
+		klog.ErrorS(err, "Failed to update OverridePolicy")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, v1.PolicyVersionResponse{ResourceVersion: updated.GetResourceVersion()})
+}
+
+// overridePolicyFromForm builds the policy object described by form, errs are the validation errors of form.
+func overridePolicyFromForm(form *v1.OverridePolicyForm, errs field.ErrorList) (ctrlclient.Object, error) {
+	objectMeta := metav1.ObjectMeta{
+		Name:        form.Name,
+		Namespace:   form.Namespace,
+		Labels:      form.Labels,
+		Annotations: form.Annotations,
+	}
+	if form.IsClusterScope {
+		if len(errs) != 0 {
+			return nil, k8serrors.NewInvalid(clusterOverridePolicyKind, form.Name, errs)
+		}
+		return &v1alpha1.ClusterOverridePolicy{ObjectMeta: objectMeta, Spec: form.Spec}, nil
+	}
+	if len(errs) != 0 {
+		return nil, k8serrors.NewInvalid(overridePolicyKind, form.Name, errs)
+	}
+	return &v1alpha1.OverridePolicy{ObjectMeta: objectMeta, Spec: form.Spec}, nil
+}
+
+func handlePreviewOverridePolicy(c *gin.Context) {
+	previewRequest := new(v1.PreviewOverridePolicyRequest)
+	if err := c.ShouldBind(&previewRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	verber, err := client.VerberClient(c.Request)
+	if err != nil {
+		klog.ErrorS(err, "Failed to init VerberClient")
+		common.Fail(c, err)
+		return
+	}
+	obj, err := verber.Get(strings.ToLower(previewRequest.Kind), previewRequest.Namespace, previewRequest.Name)
+	if err != nil {
+		klog.ErrorS(err, "Failed to get resource template")
+		common.Fail(c, err)
+		return
+	}
+	template, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		common.Fail(c, fmt.Errorf("unexpected object type %T", obj))
+		return
+	}
+	result, err := overridepolicy.GetOverridePreview(client.InClusterRuntimeClientForKarmadaAPIServer(), template, previewRequest.Cluster)
+	if err != nil {
+		klog.ErrorS(err, "GetOverridePreview failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
 func init() {
 	r := router.V1()
 	r.GET("/overridepolicy", handleGetOverridePolicyList)
@@ -183,4 +299,9 @@ func init() {
 	r.POST("/overridepolicy", handlePostOverridePolicy)
 	r.PUT("/overridepolicy", handlePutOverridePolicy)
 	r.DELETE("/overridepolicy", handleDeleteOverridePolicy)
+	r.PUT("/overridepolicy/metadata", handlePutOverridePolicyMetadata)
+	r.POST("/overridepolicy/preview", handlePreviewOverridePolicy)
+	r.POST("/overridepolicy/validate", handleValidateOverridePolicy)
+	r.POST("/overridepolicy/form", handlePostOverridePolicyForm)
+	r.PUT("/overridepolicy/form", handlePutOverridePolicyForm)
 }
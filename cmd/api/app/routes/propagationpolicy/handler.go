@@ -18,23 +18,36 @@ package propagationpolicy
 
 import (
 	"context"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/client-go/util/retry"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/klog/v2"
-	"sigs.k8s.io/yaml"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
 	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
 	"github.com/karmada-io/dashboard/pkg/client"
 	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/resource/policy"
 	"github.com/karmada-io/dashboard/pkg/resource/propagationpolicy"
 )
 
+var (
+	propagationPolicyKind        = v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.ResourceKindPropagationPolicy).GroupKind()
+	clusterPropagationPolicyKind = v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.ResourceKindClusterPropagationPolicy).GroupKind()
+)
+
 func handleGetPropagationPolicyList(c *gin.Context) {
+	listRequest := new(v1.GetPolicyListRequest)
+	if err := c.ShouldBindQuery(listRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
 	karmadaClient := client.InClusterKarmadaClient()
 	dataSelect := common.ParseDataSelectPathParameter(c)
 	namespace := common.ParseNamespacePathParameter(c)
@@ -45,6 +58,16 @@ func handleGetPropagationPolicyList(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	if listRequest.Analyze {
+		if report, err := policy.GetAnalyzeReport(c, client.InClusterRuntimeClientForKarmadaAPIServer()); err != nil {
+			klog.ErrorS(err, "Failed to analyze policies")
+		} else {
+			for i := range propagationList.PropagationPolicys {
+				item := &propagationList.PropagationPolicys[i]
+				item.Warnings = report.Warnings(policy.PropagationPolicyKey(false, item.ObjectMeta.Namespace, item.ObjectMeta.Name))
+			}
+		}
+	}
 	common.Success(c, propagationList)
 }
 func handleGetPropagationPolicyDetail(c *gin.Context) {
@@ -71,26 +94,12 @@ func handlePostPropagationPolicy(c *gin.Context) {
 		propagationpolicyRequest.Namespace = "default"
 	}
 
-	var err error
-	karmadaClient := client.InClusterKarmadaClient()
-	if propagationpolicyRequest.IsClusterScope {
-		clusterpropagationPolicy := v1alpha1.ClusterPropagationPolicy{}
-		if err = yaml.Unmarshal([]byte(propagationpolicyRequest.PropagationData), &clusterpropagationPolicy); err != nil {
-			klog.ErrorS(err, "Failed to unmarshal ClusterPropagationPolicy")
-			common.Fail(c, err)
-			return
-		}
-		_, err = karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Create(ctx, &clusterpropagationPolicy, metav1.CreateOptions{})
-	} else {
-		propagationPolicy := v1alpha1.PropagationPolicy{}
-		if err = yaml.Unmarshal([]byte(propagationpolicyRequest.PropagationData), &propagationPolicy); err != nil {
-			klog.ErrorS(err, "Failed to unmarshal PropagationPolicy")
-			common.Fail(c, err)
-			return
-		}
-		_, err = karmadaClient.PolicyV1alpha1().PropagationPolicies(propagationpolicyRequest.Namespace).Create(ctx, &propagationPolicy, metav1.CreateOptions{})
-	}
+	obj, err := propagationpolicy.Unmarshal(propagationpolicyRequest.PropagationData, propagationpolicyRequest.IsClusterScope, propagationpolicyRequest.Namespace)
 	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if err = policy.Create(ctx, client.InClusterRuntimeClientForKarmadaAPIServer(), obj); err != nil {
 		klog.ErrorS(err, "Failed to create PropagationPolicy")
 		common.Fail(c, err)
 		return
@@ -104,39 +113,41 @@ func handlePutPropagationPolicy(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
-	var err error
-	karmadaClient := client.InClusterKarmadaClient()
-	// todo check pp exist
-	if propagationpolicyRequest.IsClusterScope {
-		clusterpropagationPolicy := v1alpha1.ClusterPropagationPolicy{}
-		if err = yaml.Unmarshal([]byte(propagationpolicyRequest.PropagationData), &clusterpropagationPolicy); err != nil {
-			klog.ErrorS(err, "Failed to unmarshal ClusterPropagationPolicy")
-			common.Fail(c, err)
-			return
-		}
-		_, err = karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Update(ctx, &clusterpropagationPolicy, metav1.UpdateOptions{})
-	} else {
-		propagationPolicy := v1alpha1.PropagationPolicy{}
-		if err = yaml.Unmarshal([]byte(propagationpolicyRequest.PropagationData), &propagationPolicy); err != nil {
-			klog.ErrorS(err, "Failed to unmarshal PropagationPolicy")
-			common.Fail(c, err)
-			return
-		}
-		var oldPropagationPolicy *v1alpha1.PropagationPolicy
-		oldPropagationPolicy, err = karmadaClient.PolicyV1alpha1().PropagationPolicies(propagationpolicyRequest.Namespace).Get(ctx, propagationpolicyRequest.Name, metav1.GetOptions{})
-		if err == nil {
-			// only spec can be updated
-			propagationPolicy.TypeMeta = oldPropagationPolicy.TypeMeta
-			propagationPolicy.ObjectMeta = oldPropagationPolicy.ObjectMeta
-			_, err = karmadaClient.PolicyV1alpha1().PropagationPolicies(propagationpolicyRequest.Namespace).Update(ctx, &propagationPolicy, metav1.UpdateOptions{})
-		}
+	obj, err := propagationpolicy.Unmarshal(propagationpolicyRequest.PropagationData, propagationpolicyRequest.IsClusterScope, propagationpolicyRequest.Namespace)
+	if err != nil {
+		common.Fail(c, err)
+		return
 	}
+	key := policy.PropagationPolicyKey(propagationpolicyRequest.IsClusterScope, propagationpolicyRequest.Namespace, propagationpolicyRequest.Name)
+	// the resourceVersion in request takes precedence over the one carried by yaml.
+	resourceVersion := propagationpolicyRequest.ResourceVersion
+	if resourceVersion == "" {
+		resourceVersion = obj.GetResourceVersion()
+	}
+	updated, err := policy.UpdateSpec(ctx, client.InClusterRuntimeClientForKarmadaAPIServer(), key, resourceVersion, obj)
 	if err != nil {
 		klog.ErrorS(err, "Failed to update PropagationPolicy")
 		common.Fail(c, err)
 		return
 	}
-	common.Success(c, "ok")
+	common.Success(c, v1.PolicyVersionResponse{ResourceVersion: updated.GetResourceVersion()})
+}
+func handlePutPropagationPolicyMetadata(c *gin.Context) {
+	ctx := context.Context(c)
+	metadataRequest := new(v1.PutPolicyMetadataRequest)
+	if err := c.ShouldBind(metadataRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	key := policy.PropagationPolicyKey(metadataRequest.IsClusterScope, metadataRequest.Namespace, metadataRequest.Name)
+	updated, err := policy.UpdateMetadata(ctx, client.InClusterRuntimeClientForKarmadaAPIServer(), key,
+		metadataRequest.ResourceVersion, metadataRequest.Labels, metadataRequest.Annotations)
+	if err != nil {
+		klog.ErrorS(err, "Failed to update metadata of PropagationPolicy")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, v1.PolicyVersionResponse{ResourceVersion: updated.GetResourceVersion()})
 }
 func handleDeletePropagationPolicy(c *gin.Context) {
 	ctx := context.Context(c)
@@ -145,36 +156,116 @@ func handleDeletePropagationPolicy(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
-	var err error
-	karmadaClient := client.InClusterKarmadaClient()
-	if propagationpolicyRequest.IsClusterScope {
-		err = karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Delete(ctx, propagationpolicyRequest.Name, metav1.DeleteOptions{})
-		if err != nil {
-			klog.ErrorS(err, "Failed to delete PropagationPolicy")
-			common.Fail(c, err)
-			return
-		}
-	} else {
-		err = karmadaClient.PolicyV1alpha1().PropagationPolicies(propagationpolicyRequest.Namespace).Delete(ctx, propagationpolicyRequest.Name, metav1.DeleteOptions{})
-		if err != nil {
-			klog.ErrorS(err, "Failed to delete PropagationPolicy")
-			common.Fail(c, err)
-			return
-		}
-		_ = retry.OnError(
-			retry.DefaultRetry,
-			func(err error) bool {
-				return errors.IsNotFound(err)
-			},
-			func() error {
-				_, getErr := karmadaClient.PolicyV1alpha1().PropagationPolicies(propagationpolicyRequest.Namespace).Get(ctx, propagationpolicyRequest.Name, metav1.GetOptions{})
-				return getErr
-			})
+	key := policy.PropagationPolicyKey(propagationpolicyRequest.IsClusterScope, propagationpolicyRequest.Namespace, propagationpolicyRequest.Name)
+	timeout := time.Duration(propagationpolicyRequest.TimeoutSeconds) * time.Second
+	if err := policy.Delete(ctx, client.InClusterRuntimeClientForKarmadaAPIServer(), key, timeout); err != nil {
+		klog.ErrorS(err, "Failed to delete PropagationPolicy")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func handleGetPolicyAnalysis(c *gin.Context) {
+	report, err := policy.GetAnalyzeReport(c, client.InClusterRuntimeClientForKarmadaAPIServer())
+	if err != nil {
+		klog.ErrorS(err, "Failed to analyze policies")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, report)
+}
+
+// parsePropagationPolicyForm binds the form in request, sets default values and validates it.
+func parsePropagationPolicyForm(c *gin.Context) (*v1.PropagationPolicyForm, field.ErrorList, error) {
+	form := new(v1.PropagationPolicyForm)
+	if err := c.ShouldBind(form); err != nil {
+		return nil, nil, err
+	}
+	if form.IsClusterScope {
+		form.Namespace = ""
+	} else if form.Namespace == "" {
+		form.Namespace = "default"
+	}
+	propagationpolicy.SetDefaultPropagationSpec(form.Namespace, &form.Spec)
+	return form, propagationpolicy.ValidatePropagationPolicy(form.Name, form.Namespace, &form.Spec), nil
+}
+
+func handleValidatePropagationPolicy(c *gin.Context) {
+	form, errs, err := parsePropagationPolicyForm(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
 	}
+	common.Success(c, v1.ValidatePolicyResponse{
+		Valid:  len(errs) == 0,
+		Errors: errors.ToFieldErrors(errs),
+		Spec:   form.Spec,
+	})
+}
 
+func handlePostPropagationPolicyForm(c *gin.Context) {
+	ctx := context.Context(c)
+	form, errs, err := parsePropagationPolicyForm(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	obj, err := propagationPolicyFromForm(form, errs)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if err = policy.Create(ctx, client.InClusterRuntimeClientForKarmadaAPIServer(), obj); err != nil {
+		klog.ErrorS(err, "Failed to create PropagationPolicy")
+		common.Fail(c, err)
+		return
+	}
 	common.Success(c, "ok")
 }
 
+func handlePutPropagationPolicyForm(c *gin.Context) {
+	ctx := context.Context(c)
+	form, errs, err := parsePropagationPolicyForm(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	obj, err := propagationPolicyFromForm(form, errs)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	key := policy.PropagationPolicyKey(form.IsClusterScope, form.Namespace, form.Name)
+	updated, err := policy.UpdateSpec(ctx, client.InClusterRuntimeClientForKarmadaAPIServer(), key, form.ResourceVersion, obj)
+	if err != nil {
+		klog.ErrorS(err, "Failed to update PropagationPolicy")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, v1.PolicyVersionResponse{ResourceVersion: updated.GetResourceVersion()})
+}
+
+// propagationPolicyFromForm builds the policy object described by form, errs are the validation errors of form.
+func propagationPolicyFromForm(form *v1.PropagationPolicyForm, errs field.ErrorList) (ctrlclient.Object, error) {
+	objectMeta := metav1.ObjectMeta{
+		Name:        form.Name,
+		Namespace:   form.Namespace,
+		Labels:      form.Labels,
+		Annotations: form.Annotations,
+	}
+	if form.IsClusterScope {
+		if len(errs) != 0 {
+			return nil, k8serrors.NewInvalid(clusterPropagationPolicyKind, form.Name, errs)
+		}
+		return &v1alpha1.ClusterPropagationPolicy{ObjectMeta: objectMeta, Spec: form.Spec}, nil
+	}
+	if len(errs) != 0 {
+		return nil, k8serrors.NewInvalid(propagationPolicyKind, form.Name, errs)
+	}
+	return &v1alpha1.PropagationPolicy{ObjectMeta: objectMeta, Spec: form.Spec}, nil
+}
+
 func init() {
 	r := router.V1()
 	r.GET("/propagationpolicy", handleGetPropagationPolicyList)
@@ -182,4 +273,9 @@ func init() {
 	r.POST("/propagationpolicy", handlePostPropagationPolicy)
 	r.PUT("/propagationpolicy", handlePutPropagationPolicy)
 	r.DELETE("/propagationpolicy", handleDeletePropagationPolicy)
+	r.PUT("/propagationpolicy/metadata", handlePutPropagationPolicyMetadata)
+	r.POST("/propagationpolicy/validate", handleValidatePropagationPolicy)
+	r.GET("/policy/analysis", handleGetPolicyAnalysis)
+	r.POST("/propagationpolicy/form", handlePostPropagationPolicyForm)
+	r.PUT("/propagationpolicy/form", handlePutPropagationPolicyForm)
 }
@@ -0,0 +1,189 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceinterpretercustomization
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/util/interpreter"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/resource/resourceinterpretercustomization"
+)
+
+var resourceInterpreterCustomizationKind = configv1alpha1.SchemeGroupVersion.WithKind(configv1alpha1.ResourceKindResourceInterpreterCustomization).GroupKind()
+
+func handleGetResourceInterpreterCustomizationList(c *gin.Context) {
+	karmadaClient := client.InClusterKarmadaClient()
+	dataSelect := common.ParseDataSelectPathParameter(c)
+	result, err := resourceinterpretercustomization.GetResourceInterpreterCustomizationList(karmadaClient, dataSelect)
+	if err != nil {
+		klog.ErrorS(err, "Failed to GetResourceInterpreterCustomizationList")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleGetResourceInterpreterCustomizationDetail(c *gin.Context) {
+	karmadaClient := client.InClusterKarmadaClient()
+	name := c.Param("name")
+	result, err := resourceinterpretercustomization.GetResourceInterpreterCustomizationDetail(karmadaClient, name)
+	if err != nil {
+		klog.ErrorS(err, "GetResourceInterpreterCustomizationDetail failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handlePostResourceInterpreterCustomization(c *gin.Context) {
+	ctx := context.Context(c)
+	customizationRequest := new(v1.ResourceInterpreterCustomizationRequest)
+	if err := c.ShouldBind(customizationRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if errs := resourceinterpretercustomization.ValidateResourceInterpreterCustomization(customizationRequest.Name, &customizationRequest.Spec); len(errs) != 0 {
+		common.Fail(c, k8serrors.NewInvalid(resourceInterpreterCustomizationKind, customizationRequest.Name, errs))
+		return
+	}
+	karmadaClient := client.InClusterKarmadaClient()
+	_, err := karmadaClient.ConfigV1alpha1().ResourceInterpreterCustomizations().Create(ctx, &configv1alpha1.ResourceInterpreterCustomization{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: customizationRequest.Name,
+		},
+		Spec: customizationRequest.Spec,
+	}, metav1.CreateOptions{})
+	if err != nil {
+		klog.ErrorS(err, "Failed to create ResourceInterpreterCustomization")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func handlePutResourceInterpreterCustomization(c *gin.Context) {
+	ctx := context.Context(c)
+	customizationRequest := new(v1.ResourceInterpreterCustomizationRequest)
+	if err := c.ShouldBind(customizationRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if customizationRequest.ResourceVersion == "" {
+		common.Fail(c, k8serrors.NewInvalid(resourceInterpreterCustomizationKind, customizationRequest.Name, field.ErrorList{
+			field.Required(field.NewPath("metadata", "resourceVersion"), "the resourceVersion the ResourceInterpreterCustomization was read at is required"),
+		}))
+		return
+	}
+	if errs := resourceinterpretercustomization.ValidateResourceInterpreterCustomization(customizationRequest.Name, &customizationRequest.Spec); len(errs) != 0 {
+		common.Fail(c, k8serrors.NewInvalid(resourceInterpreterCustomizationKind, customizationRequest.Name, errs))
+		return
+	}
+	karmadaClient := client.InClusterKarmadaClient()
+	customization, err := karmadaClient.ConfigV1alpha1().ResourceInterpreterCustomizations().Get(ctx, customizationRequest.Name, metav1.GetOptions{})
+	if err == nil {
+		customization.ResourceVersion = customizationRequest.ResourceVersion
+		customization.Spec = customizationRequest.Spec
+		_, err = karmadaClient.ConfigV1alpha1().ResourceInterpreterCustomizations().Update(ctx, customization, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		klog.ErrorS(err, "Failed to update ResourceInterpreterCustomization")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func handleDeleteResourceInterpreterCustomization(c *gin.Context) {
+	ctx := context.Context(c)
+	name := c.Param("name")
+	karmadaClient := client.InClusterKarmadaClient()
+	err := karmadaClient.ConfigV1alpha1().ResourceInterpreterCustomizations().Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		klog.ErrorS(err, "Failed to delete ResourceInterpreterCustomization")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func handleGetResourceInterpreterCustomizationOperations(c *gin.Context) {
+	common.Success(c, resourceinterpretercustomization.Operations())
+}
+
+func handleExecuteResourceInterpreterCustomization(c *gin.Context) {
+	ctx := context.Context(c)
+	executeRequest := new(v1.ExecuteResourceInterpreterCustomizationRequest)
+	if err := c.ShouldBind(executeRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	customization := &configv1alpha1.ResourceInterpreterCustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: executeRequest.Name},
+	}
+	switch {
+	case executeRequest.Spec != nil:
+		customization.Spec = *executeRequest.Spec
+	case executeRequest.Name != "":
+		karmadaClient := client.InClusterKarmadaClient()
+		existing, err := karmadaClient.ConfigV1alpha1().ResourceInterpreterCustomizations().Get(ctx, executeRequest.Name, metav1.GetOptions{})
+		if err != nil {
+			klog.ErrorS(err, "Failed to get ResourceInterpreterCustomization")
+			common.Fail(c, err)
+			return
+		}
+		customization = existing
+	default:
+		common.Fail(c, fmt.Errorf("either name or spec of the customization is required"))
+		return
+	}
+
+	result, err := resourceinterpretercustomization.Execute(customization, executeRequest.Operation, interpreter.RuleArgs{
+		Desired:  executeRequest.Desired,
+		Observed: executeRequest.Observed,
+		Status:   executeRequest.Status,
+		Replica:  executeRequest.Replica,
+	})
+	if err != nil {
+		klog.ErrorS(err, "Failed to execute ResourceInterpreterCustomization")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func init() {
+	r := router.V1()
+	r.GET("/resourceinterpretercustomization", handleGetResourceInterpreterCustomizationList)
+	r.GET("/resourceinterpretercustomization/:name", handleGetResourceInterpreterCustomizationDetail)
+	r.POST("/resourceinterpretercustomization", handlePostResourceInterpreterCustomization)
+	r.PUT("/resourceinterpretercustomization", handlePutResourceInterpreterCustomization)
+	r.DELETE("/resourceinterpretercustomization/:name", handleDeleteResourceInterpreterCustomization)
+	r.GET("/resourceinterpretercustomization/operations", handleGetResourceInterpreterCustomizationOperations)
+	r.POST("/resourceinterpretercustomization/execute", handleExecuteResourceInterpreterCustomization)
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceinterpreterwebhookconfiguration
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/resource/resourceinterpreterwebhookconfiguration"
+)
+
+var webhookConfigurationKind = configv1alpha1.SchemeGroupVersion.WithKind(configv1alpha1.ResourceKindResourceInterpreterWebhookConfiguration).GroupKind()
+
+func handleGetResourceInterpreterWebhookConfigurationList(c *gin.Context) {
+	karmadaClient := client.InClusterKarmadaClient()
+	dataSelect := common.ParseDataSelectPathParameter(c)
+	result, err := resourceinterpreterwebhookconfiguration.GetResourceInterpreterWebhookConfigurationList(karmadaClient, dataSelect)
+	if err != nil {
+		klog.ErrorS(err, "Failed to GetResourceInterpreterWebhookConfigurationList")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleGetResourceInterpreterWebhookConfigurationDetail(c *gin.Context) {
+	karmadaClient := client.InClusterKarmadaClient()
+	name := c.Param("name")
+	result, err := resourceinterpreterwebhookconfiguration.GetResourceInterpreterWebhookConfigurationDetail(karmadaClient, name)
+	if err != nil {
+		klog.ErrorS(err, "GetResourceInterpreterWebhookConfigurationDetail failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handlePostResourceInterpreterWebhookConfiguration(c *gin.Context) {
+	ctx := context.Context(c)
+	configurationRequest := new(v1.ResourceInterpreterWebhookConfigurationRequest)
+	if err := c.ShouldBind(configurationRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if errs := resourceinterpreterwebhookconfiguration.ValidateResourceInterpreterWebhookConfiguration(configurationRequest.Name, configurationRequest.Webhooks); len(errs) != 0 {
+		common.Fail(c, k8serrors.NewInvalid(webhookConfigurationKind, configurationRequest.Name, errs))
+		return
+	}
+	karmadaClient := client.InClusterKarmadaClient()
+	_, err := karmadaClient.ConfigV1alpha1().ResourceInterpreterWebhookConfigurations().Create(ctx, &configv1alpha1.ResourceInterpreterWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: configurationRequest.Name,
+		},
+		Webhooks: configurationRequest.Webhooks,
+	}, metav1.CreateOptions{})
+	if err != nil {
+		klog.ErrorS(err, "Failed to create ResourceInterpreterWebhookConfiguration")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func handlePutResourceInterpreterWebhookConfiguration(c *gin.Context) {
+	ctx := context.Context(c)
+	configurationRequest := new(v1.ResourceInterpreterWebhookConfigurationRequest)
+	if err := c.ShouldBind(configurationRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if configurationRequest.ResourceVersion == "" {
+		common.Fail(c, k8serrors.NewInvalid(webhookConfigurationKind, configurationRequest.Name, field.ErrorList{
+			field.Required(field.NewPath("metadata", "resourceVersion"), "the resourceVersion the ResourceInterpreterWebhookConfiguration was read at is required"),
+		}))
+		return
+	}
+	if errs := resourceinterpreterwebhookconfiguration.ValidateResourceInterpreterWebhookConfiguration(configurationRequest.Name, configurationRequest.Webhooks); len(errs) != 0 {
+		common.Fail(c, k8serrors.NewInvalid(webhookConfigurationKind, configurationRequest.Name, errs))
+		return
+	}
+	karmadaClient := client.InClusterKarmadaClient()
+	configuration, err := karmadaClient.ConfigV1alpha1().ResourceInterpreterWebhookConfigurations().Get(ctx, configurationRequest.Name, metav1.GetOptions{})
+	if err == nil {
+		configuration.ResourceVersion = configurationRequest.ResourceVersion
+		configuration.Webhooks = configurationRequest.Webhooks
+		_, err = karmadaClient.ConfigV1alpha1().ResourceInterpreterWebhookConfigurations().Update(ctx, configuration, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		klog.ErrorS(err, "Failed to update ResourceInterpreterWebhookConfiguration")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func handleDeleteResourceInterpreterWebhookConfiguration(c *gin.Context) {
+	ctx := context.Context(c)
+	name := c.Param("name")
+	karmadaClient := client.InClusterKarmadaClient()
+	err := karmadaClient.ConfigV1alpha1().ResourceInterpreterWebhookConfigurations().Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		klog.ErrorS(err, "Failed to delete ResourceInterpreterWebhookConfiguration")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func init() {
+	r := router.V1()
+	r.GET("/resourceinterpreterwebhookconfiguration", handleGetResourceInterpreterWebhookConfigurationList)
+	r.GET("/resourceinterpreterwebhookconfiguration/:name", handleGetResourceInterpreterWebhookConfigurationDetail)
+	r.POST("/resourceinterpreterwebhookconfiguration", handlePostResourceInterpreterWebhookConfiguration)
+	r.PUT("/resourceinterpreterwebhookconfiguration", handlePutResourceInterpreterWebhookConfiguration)
+	r.DELETE("/resourceinterpreterwebhookconfiguration/:name", handleDeleteResourceInterpreterWebhookConfiguration)
+}
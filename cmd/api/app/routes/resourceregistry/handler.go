@@ -0,0 +1,147 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceregistry
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	searchv1alpha1 "github.com/karmada-io/karmada/pkg/apis/search/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/resource/resourceregistry"
+)
+
+var resourceRegistryKind = schema.GroupKind{Group: searchv1alpha1.GroupName, Kind: searchv1alpha1.ResourceKindResourceRegistry}
+
+func handleGetResourceRegistryList(c *gin.Context) {
+	karmadaClient := client.InClusterKarmadaClient()
+	dataSelect := common.ParseDataSelectPathParameter(c)
+	result, err := resourceregistry.GetResourceRegistryList(karmadaClient, dataSelect)
+	if err != nil {
+		klog.ErrorS(err, "Failed to GetResourceRegistryList")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleGetResourceRegistryDetail(c *gin.Context) {
+	karmadaClient := client.InClusterKarmadaClient()
+	name := c.Param("name")
+	result, err := resourceregistry.GetResourceRegistryDetail(karmadaClient, name)
+	if err != nil {
+		klog.ErrorS(err, "GetResourceRegistryDetail failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handlePostResourceRegistry(c *gin.Context) {
+	ctx := context.Context(c)
+	registryRequest := new(v1.ResourceRegistryRequest)
+	if err := c.ShouldBind(registryRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	spec := searchv1alpha1.ResourceRegistrySpec{
+		TargetCluster:     registryRequest.TargetCluster,
+		ResourceSelectors: registryRequest.ResourceSelectors,
+	}
+	if errs := resourceregistry.ValidateResourceRegistry(registryRequest.Name, &spec); len(errs) != 0 {
+		common.Fail(c, k8serrors.NewInvalid(resourceRegistryKind, registryRequest.Name, errs))
+		return
+	}
+	karmadaClient := client.InClusterKarmadaClient()
+	_, err := karmadaClient.SearchV1alpha1().ResourceRegistries().Create(ctx, &searchv1alpha1.ResourceRegistry{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: registryRequest.Name,
+		},
+		Spec: spec,
+	}, metav1.CreateOptions{})
+	if err != nil {
+		klog.ErrorS(err, "Failed to create ResourceRegistry")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func handlePutResourceRegistry(c *gin.Context) {
+	ctx := context.Context(c)
+	registryRequest := new(v1.ResourceRegistryRequest)
+	if err := c.ShouldBind(registryRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if registryRequest.ResourceVersion == "" {
+		common.Fail(c, k8serrors.NewInvalid(resourceRegistryKind, registryRequest.Name, field.ErrorList{
+			field.Required(field.NewPath("metadata", "resourceVersion"), "the resourceVersion the ResourceRegistry was read at is required"),
+		}))
+		return
+	}
+	karmadaClient := client.InClusterKarmadaClient()
+	registry, err := karmadaClient.SearchV1alpha1().ResourceRegistries().Get(ctx, registryRequest.Name, metav1.GetOptions{})
+	if err == nil {
+		registry.ResourceVersion = registryRequest.ResourceVersion
+		// the backend store can't be set from the dashboard, keep it as it is
+		registry.Spec.TargetCluster = registryRequest.TargetCluster
+		registry.Spec.ResourceSelectors = registryRequest.ResourceSelectors
+		if errs := resourceregistry.ValidateResourceRegistry(registry.Name, &registry.Spec); len(errs) != 0 {
+			common.Fail(c, k8serrors.NewInvalid(resourceRegistryKind, registry.Name, errs))
+			return
+		}
+		_, err = karmadaClient.SearchV1alpha1().ResourceRegistries().Update(ctx, registry, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		klog.ErrorS(err, "Failed to update ResourceRegistry")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func handleDeleteResourceRegistry(c *gin.Context) {
+	ctx := context.Context(c)
+	name := c.Param("name")
+	karmadaClient := client.InClusterKarmadaClient()
+	err := karmadaClient.SearchV1alpha1().ResourceRegistries().Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		klog.ErrorS(err, "Failed to delete ResourceRegistry")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func init() {
+	r := router.V1()
+	r.GET("/resourceregistry", handleGetResourceRegistryList)
+	r.GET("/resourceregistry/:name", handleGetResourceRegistryDetail)
+	r.POST("/resourceregistry", handlePostResourceRegistry)
+	r.PUT("/resourceregistry", handlePutResourceRegistry)
+	r.DELETE("/resourceregistry/:name", handleDeleteResourceRegistry)
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package search
+
+import (
+	"context"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/resource/search"
+)
+
+func handleSearch(c *gin.Context) {
+	req := new(v1.SearchRequest)
+	if err := c.ShouldBindQuery(req); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if req.Name == "" && req.LabelSelector == "" {
+		common.Fail(c, errors.NewBadRequest("name or labelSelector is required"))
+		return
+	}
+	targets, cache, err := searchTargets(c, req)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	query := search.Query{
+		Name:          req.Name,
+		LabelSelector: req.LabelSelector,
+		Namespace:     req.Namespace,
+		Limit:         req.Limit,
+		Timeout:       time.Duration(req.TimeoutSeconds) * time.Second,
+	}
+	for _, kind := range req.Kinds {
+		query.Kinds = append(query.Kinds, types.ResourceKind(kind))
+	}
+	result, err := search.Search(c, targets, cache, query)
+	if err != nil {
+		klog.ErrorS(err, "Failed to search resources", "name", req.Name, "labelSelector", req.LabelSelector)
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+// searchTargets returns the control plane and the member clusters of the request, along with the cache of
+// karmada-search when it's installed.
+func searchTargets(ctx context.Context, req *v1.SearchRequest) ([]search.Target, *search.Cache, error) {
+	targets := make([]search.Target, 0)
+	if !req.ExcludeControlPlane {
+		targets = append(targets, search.Target{Client: client.InClusterMetadataClientForKarmadaAPIServer()})
+	}
+	karmadaClient := client.InClusterKarmadaClient()
+	clusters, err := karmadaClient.ClusterV1alpha1().Clusters().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	selected := sets.New(req.Clusters...)
+	for _, cluster := range clusters.Items {
+		if selected.Len() > 0 && !selected.Has(cluster.Name) {
+			continue
+		}
+		targets = append(targets, search.Target{Cluster: cluster.Name, Client: client.InClusterMetadataClientForMemberCluster(cluster.Name)})
+	}
+
+	if !client.IsKarmadaSearchEnabled() {
+		return targets, nil, nil
+	}
+	proxyClient := client.InClusterClientForKarmadaSearchProxy()
+	if proxyClient == nil {
+		return targets, nil, nil
+	}
+	registries, err := karmadaClient.SearchV1alpha1().ResourceRegistries().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		// the member clusters are listed one by one instead
+		klog.ErrorS(err, "Failed to list ResourceRegistries")
+		return targets, nil, nil
+	}
+	return targets, search.NewCache(proxyClient.Discovery().RESTClient(), registries.Items, clusters.Items, req.Namespace), nil
+}
+
+func handleGetSearchKinds(c *gin.Context) {
+	common.Success(c, search.Kinds())
+}
+
+func init() {
+	r := router.V1()
+	r.GET("/search", handleSearch)
+	r.GET("/search/kind", handleGetSearchKinds)
+}
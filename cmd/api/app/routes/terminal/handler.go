@@ -0,0 +1,142 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terminal
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/terminal"
+)
+
+// currentUser authenticates the user of the request against the karmada apiserver.
+func currentUser(c *gin.Context) (*terminal.User, error) {
+	if !client.HasAuthorizationHeader(c.Request) {
+		return nil, errors.NewUnauthorized("MSG_LOGIN_UNAUTHORIZED_ERROR")
+	}
+	return terminal.ReviewUser(c.Request.Context(), client.InClusterClientForKarmadaAPIServer(), client.GetBearerToken(c.Request))
+}
+
+func handleGetWebTerminals(c *gin.Context) {
+	user, err := currentUser(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	result, err := terminal.ListWebTerminals(c.Request.Context(), user)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handlePostWebTerminal(c *gin.Context) {
+	user, err := currentUser(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	karmadaConfig, _, err := client.GetKarmadaConfig()
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	hostConfig, _, err := client.GetKubeConfig()
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	result, err := terminal.CreateWebTerminal(c.Request.Context(), user, karmadaConfig, hostConfig)
+	if err != nil {
+		klog.ErrorS(err, "Failed to create web terminal", "user", user.Name)
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleDeleteWebTerminal(c *gin.Context) {
+	user, err := currentUser(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if err := terminal.DeleteWebTerminal(c.Request.Context(), user, c.Param("name")); err != nil {
+		klog.ErrorS(err, "Failed to delete web terminal", "user", user.Name, "name", c.Param("name"))
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func handleAttachWebTerminal(c *gin.Context) {
+	if !websocket.IsWebSocketUpgrade(c.Request) {
+		common.Fail(c, errors.NewBadRequest("attaching a web terminal requires a websocket connection"))
+		return
+	}
+	user, err := currentUser(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	hostConfig, _, err := client.GetKubeConfig()
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+
+	conn, err := common.UpgradeWebSocket(c)
+	if err != nil {
+		klog.ErrorS(err, "Failed to upgrade to websocket")
+		return
+	}
+	session, err := terminal.NewSession(conn, terminal.SessionInfo{}, terminal.DefaultIdleTimeout)
+	if err != nil {
+		klog.ErrorS(err, "Failed to create terminal session")
+		_ = conn.Close()
+		return
+	}
+	defer session.Close()
+
+	// the request context is not cancelled once the connection is hijacked, the session tells when it ends
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-session.Done()
+		cancel()
+	}()
+	if err := terminal.AttachWebTerminal(ctx, user, c.Param("name"), hostConfig, session); err != nil && ctx.Err() == nil {
+		klog.ErrorS(err, "Failed to attach web terminal", "user", user.Name, "name", c.Param("name"))
+		session.Toast(err.Error())
+	}
+}
+
+func init() {
+	r := router.V1()
+	r.GET("/terminal", handleGetWebTerminals)
+	r.POST("/terminal", handlePostWebTerminal)
+	r.DELETE("/terminal/:name", handleDeleteWebTerminal)
+	r.GET("/terminal/:name/attach", handleAttachWebTerminal)
+}
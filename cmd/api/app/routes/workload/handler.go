@@ -0,0 +1,127 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"github.com/gin-gonic/gin"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/resource/workload"
+)
+
+func handleScaleWorkload(c *gin.Context) {
+	req := new(v1.ScaleWorkloadRequest)
+	if err := c.ShouldBind(req); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	kind, namespace, name := types.ResourceKind(c.Param("kind")), c.Param("namespace"), c.Param("name")
+	k8sClient := client.InClusterClientForKarmadaAPIServer()
+	karmadaClient := client.InClusterKarmadaClient()
+	result, err := workload.Scale(k8sClient, karmadaClient, kind, namespace, name, *req.Replicas, req.DryRun)
+	if err != nil {
+		klog.ErrorS(err, "Failed to scale workload", "kind", kind, "namespace", namespace, "name", name)
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleRestartWorkload(c *gin.Context) {
+	kind, namespace, name := types.ResourceKind(c.Param("kind")), c.Param("namespace"), c.Param("name")
+	k8sClient := client.InClusterClientForKarmadaAPIServer()
+	if err := workload.Restart(k8sClient, kind, namespace, name); err != nil {
+		klog.ErrorS(err, "Failed to restart workload", "kind", kind, "namespace", namespace, "name", name)
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func handleCreateWorkload(c *gin.Context) {
+	req := new(v1.CreateWorkloadRequest)
+	if err := c.ShouldBind(req); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	kind := types.ResourceKind(c.Param("kind"))
+	obj, err := workload.Decode(kind, req.Content, req.Namespace)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	var pp *policyv1alpha1.PropagationPolicy
+	if req.PropagationPolicy != nil {
+		if pp, err = workload.NewPropagationPolicy(obj, req.PropagationPolicy.Name, req.PropagationPolicy.Spec); err != nil {
+			common.Fail(c, err)
+			return
+		}
+	}
+	result, err := workload.Create(c, client.InClusterRuntimeClientForKarmadaAPIServer(), obj, pp)
+	if err != nil {
+		klog.ErrorS(err, "Failed to create workload", "kind", kind, "namespace", obj.GetNamespace(), "name", obj.GetName())
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleUpdateWorkload(c *gin.Context) {
+	req := new(v1.UpdateWorkloadRequest)
+	if err := c.ShouldBind(req); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	kind, namespace, name := types.ResourceKind(c.Param("kind")), c.Param("namespace"), c.Param("name")
+	obj, err := workload.Decode(kind, req.Content, namespace)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	result, err := workload.Update(c, client.InClusterRuntimeClientForKarmadaAPIServer(), kind, namespace, name, obj)
+	if err != nil {
+		klog.ErrorS(err, "Failed to update workload", "kind", kind, "namespace", namespace, "name", name)
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleDeleteWorkload(c *gin.Context) {
+	kind, namespace, name := types.ResourceKind(c.Param("kind")), c.Param("namespace"), c.Param("name")
+	if err := workload.Delete(c, client.InClusterRuntimeClientForKarmadaAPIServer(), kind, namespace, name); err != nil {
+		klog.ErrorS(err, "Failed to delete workload", "kind", kind, "namespace", namespace, "name", name)
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func init() {
+	r := router.V1()
+	r.POST("/workload/:kind", handleCreateWorkload)
+	r.PUT("/workload/:kind/:namespace/:name", handleUpdateWorkload)
+	r.DELETE("/workload/:kind/:namespace/:name", handleDeleteWorkload)
+	r.POST("/workload/:kind/:namespace/:name/scale", handleScaleWorkload)
+	r.POST("/workload/:kind/:namespace/:name/restart", handleRestartWorkload)
+}
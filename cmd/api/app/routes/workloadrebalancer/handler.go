@@ -0,0 +1,150 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloadrebalancer
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	appsv1alpha1 "github.com/karmada-io/karmada/pkg/apis/apps/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/resource/workloadrebalancer"
+)
+
+var workloadRebalancerKind = appsv1alpha1.SchemeGroupVersion.WithKind(appsv1alpha1.ResourceKindWorkloadRebalancer).GroupKind()
+
+func handleGetWorkloadRebalancerList(c *gin.Context) {
+	karmadaClient := client.InClusterKarmadaClient()
+	dataSelect := common.ParseDataSelectPathParameter(c)
+	result, err := workloadrebalancer.GetWorkloadRebalancerList(karmadaClient, dataSelect)
+	if err != nil {
+		klog.ErrorS(err, "Failed to GetWorkloadRebalancerList")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handleGetWorkloadRebalancerDetail(c *gin.Context) {
+	karmadaClient := client.InClusterKarmadaClient()
+	name := c.Param("name")
+	result, err := workloadrebalancer.GetWorkloadRebalancerDetail(karmadaClient, name)
+	if err != nil {
+		klog.ErrorS(err, "GetWorkloadRebalancerDetail failed")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func handlePostWorkloadRebalancer(c *gin.Context) {
+	ctx := context.Context(c)
+	rebalancerRequest := new(v1.WorkloadRebalancerRequest)
+	if err := c.ShouldBind(rebalancerRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	karmadaClient := client.InClusterKarmadaClient()
+	_, err := karmadaClient.AppsV1alpha1().WorkloadRebalancers().Create(ctx, &appsv1alpha1.WorkloadRebalancer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: rebalancerRequest.Name,
+		},
+		Spec: appsv1alpha1.WorkloadRebalancerSpec{
+			Workloads:               rebalancerRequest.Workloads,
+			TTLSecondsAfterFinished: rebalancerRequest.TTLSecondsAfterFinished,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		klog.ErrorS(err, "Failed to create WorkloadRebalancer")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func handlePutWorkloadRebalancer(c *gin.Context) {
+	ctx := context.Context(c)
+	rebalancerRequest := new(v1.WorkloadRebalancerRequest)
+	if err := c.ShouldBind(rebalancerRequest); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if rebalancerRequest.ResourceVersion == "" {
+		common.Fail(c, k8serrors.NewInvalid(workloadRebalancerKind, rebalancerRequest.Name, field.ErrorList{
+			field.Required(field.NewPath("metadata", "resourceVersion"), "the resourceVersion the WorkloadRebalancer was read at is required"),
+		}))
+		return
+	}
+	karmadaClient := client.InClusterKarmadaClient()
+	rebalancer, err := karmadaClient.AppsV1alpha1().WorkloadRebalancers().Get(ctx, rebalancerRequest.Name, metav1.GetOptions{})
+	if err == nil {
+		rebalancer.ResourceVersion = rebalancerRequest.ResourceVersion
+		rebalancer.Spec.Workloads = rebalancerRequest.Workloads
+		rebalancer.Spec.TTLSecondsAfterFinished = rebalancerRequest.TTLSecondsAfterFinished
+		_, err = karmadaClient.AppsV1alpha1().WorkloadRebalancers().Update(ctx, rebalancer, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		klog.ErrorS(err, "Failed to update WorkloadRebalancer")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func handleDeleteWorkloadRebalancer(c *gin.Context) {
+	ctx := context.Context(c)
+	name := c.Param("name")
+	karmadaClient := client.InClusterKarmadaClient()
+	err := karmadaClient.AppsV1alpha1().WorkloadRebalancers().Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		klog.ErrorS(err, "Failed to delete WorkloadRebalancer")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func handleGetDivergentWorkloadList(c *gin.Context) {
+	karmadaClient := client.InClusterKarmadaClient()
+	nsQuery := common.ParseNamespacePathParameter(c)
+	result, err := workloadrebalancer.GetDivergentWorkloadList(karmadaClient, nsQuery)
+	if err != nil {
+		klog.ErrorS(err, "Failed to GetDivergentWorkloadList")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, result)
+}
+
+func init() {
+	r := router.V1()
+	r.GET("/workloadrebalancer", handleGetWorkloadRebalancerList)
+	r.GET("/workloadrebalancer/:name", handleGetWorkloadRebalancerDetail)
+	r.POST("/workloadrebalancer", handlePostWorkloadRebalancer)
+	r.PUT("/workloadrebalancer", handlePutWorkloadRebalancer)
+	r.DELETE("/workloadrebalancer/:name", handleDeleteWorkloadRebalancer)
+	r.GET("/divergentworkload", handleGetDivergentWorkloadList)
+	r.GET("/divergentworkload/:namespace", handleGetDivergentWorkloadList)
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	autoscalingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/autoscaling/v1alpha1"
+)
+
+// FederatedHPARequest defines the request structure for creating or updating a FederatedHPA.
+type FederatedHPARequest struct {
+	Namespace string                               `json:"namespace" binding:"required"`
+	Name      string                               `json:"name" binding:"required"`
+	Spec      autoscalingv1alpha1.FederatedHPASpec `json:"spec" binding:"required"`
+	// ResourceVersion must be set when updating to the version of the FederatedHPA the client read,
+	// the update is rejected with a conflict if it has been modified since then.
+	ResourceVersion string `json:"resourceVersion"`
+}
+
+// CronFederatedHPARequest defines the request structure for creating or updating a CronFederatedHPA.
+type CronFederatedHPARequest struct {
+	Namespace string                                   `json:"namespace" binding:"required"`
+	Name      string                                   `json:"name" binding:"required"`
+	Spec      autoscalingv1alpha1.CronFederatedHPASpec `json:"spec" binding:"required"`
+	// ResourceVersion must be set when updating to the version of the CronFederatedHPA the client read,
+	// the update is rejected with a conflict if it has been modified since then.
+	ResourceVersion string `json:"resourceVersion"`
+}
@@ -50,14 +50,31 @@ type TaintRequest struct {
 	Value  string             `json:"value"`
 }
 
-// PutClusterRequest is the request body for updating a cluster.
+// PutClusterRequest is the request body for updating a cluster, fields not set are left unchanged.
 type PutClusterRequest struct {
 	Labels *[]LabelRequest `json:"labels"`
 	Taints *[]TaintRequest `json:"taints"`
+
+	Provider *string   `json:"provider"`
+	Region   *string   `json:"region"`
+	Zones    *[]string `json:"zones"`
+	// ResourceModels is the customized resource modeling of the cluster.
+	ResourceModels              *[]v1alpha1.ResourceModel      `json:"resourceModels"`
+	ProxyURL                    *string                        `json:"proxyURL"`
+	ProxyHeader                 *map[string]string             `json:"proxyHeader"`
+	InsecureSkipTLSVerification *bool                          `json:"insecureSkipTLSVerification"`
+	SecretRef                   *v1alpha1.LocalSecretReference `json:"secretRef"`
+	ImpersonatorSecretRef       *v1alpha1.LocalSecretReference `json:"impersonatorSecretRef"`
+
+	// ResourceVersion is the version of the cluster the changes are based on, it is required and the update
+	// is rejected with a conflict if the cluster has been modified since the client read it.
+	ResourceVersion string `json:"resourceVersion" binding:"required"`
 }
 
 // PutClusterResponse is the response body for updating a cluster.
-type PutClusterResponse struct{}
+type PutClusterResponse struct {
+	ResourceVersion string `json:"resourceVersion"`
+}
 
 // DeleteClusterRequest is the request body for deleting a cluster.
 type DeleteClusterRequest struct {
@@ -67,3 +84,21 @@ type DeleteClusterRequest struct {
 // DeleteClusterResponse is the response body for deleting a cluster.
 type DeleteClusterResponse struct {
 }
+
+// DrainClusterRequest is the request body for draining a cluster.
+type DrainClusterRequest struct {
+	// BatchSize is the number of workloads evicted at the same time, defaults to 5.
+	BatchSize int `json:"batchSize"`
+	// BatchIntervalSeconds is the time to wait between two batches.
+	BatchIntervalSeconds int `json:"batchIntervalSeconds"`
+	// BatchTimeoutSeconds is how long to wait for a batch to be rescheduled, defaults to 300.
+	BatchTimeoutSeconds int `json:"batchTimeoutSeconds"`
+	// Force starts draining even if the other clusters lack capacity.
+	Force bool `json:"force"`
+}
+
+// EstimateReplicasRequest is the request body for estimating the replicas a cluster can take in.
+type EstimateReplicasRequest struct {
+	// ResourceRequest is the resource request of a single replica.
+	ResourceRequest corev1.ResourceList `json:"resourceRequest" binding:"required"`
+}
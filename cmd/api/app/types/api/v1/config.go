@@ -23,4 +23,5 @@ type SetDashboardConfigRequest struct {
 	DockerRegistries []config.DockerRegistry `json:"docker_registries"`
 	ChartRegistries  []config.ChartRegistry  `json:"chart_registries"`
 	MenuConfigs      []config.MenuConfig     `json:"menu_configs"`
+	Terminal         *config.TerminalConfig  `json:"terminal"`
 }
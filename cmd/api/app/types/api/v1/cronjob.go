@@ -0,0 +1,28 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// GetCronJobJobsRequest defines the request structure for listing the jobs of a cron job, the running jobs
+// are listed when Active is set, the finished ones otherwise.
+type GetCronJobJobsRequest struct {
+	Active bool `form:"active"`
+}
+
+// GetCronJobScheduleRequest defines the request structure for getting the next runs of a cron job.
+type GetCronJobScheduleRequest struct {
+	Count int `form:"count" binding:"omitempty,min=1,max=100"`
+}
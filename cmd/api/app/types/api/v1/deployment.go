@@ -25,3 +25,15 @@ type CreateDeploymentRequest struct {
 
 // CreateDeploymentResponse defines the response structure for creating a deployment.
 type CreateDeploymentResponse struct{}
+
+// GetDeploymentRevisionDiffRequest defines the request structure for comparing two revisions of a deployment,
+// the revisions are identified by their pod template hash.
+type GetDeploymentRevisionDiffRequest struct {
+	From string `form:"from" binding:"required"`
+	To   string `form:"to" binding:"required"`
+}
+
+// RollbackDeploymentRequest defines the request structure for rolling a deployment back to a revision.
+type RollbackDeploymentRequest struct {
+	Hash string `json:"hash" binding:"required"`
+}
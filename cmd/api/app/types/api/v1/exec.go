@@ -0,0 +1,25 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// ExecPodRequest is the query of opening a terminal into a container.
+type ExecPodRequest struct {
+	// Container to exec into, defaults to the default container of the pod.
+	Container string `form:"container"`
+	// Command to run, a shell is detected when it's empty.
+	Command []string `form:"command"`
+}
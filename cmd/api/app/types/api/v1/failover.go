@@ -0,0 +1,37 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// EvictionTaint is a NoExecute taint to add to a cluster.
+type EvictionTaint struct {
+	Key   string `json:"key" binding:"required"`
+	Value string `json:"value"`
+}
+
+// AddEvictionTaintRequest is the request body for adding eviction taints to a cluster.
+type AddEvictionTaintRequest struct {
+	Taints []EvictionTaint `json:"taints" binding:"required,min=1,dive"`
+	// DryRun reports the affected workloads without changing the cluster.
+	DryRun bool `json:"dryRun"`
+}
+
+// RemoveEvictionTaintRequest is the request body for removing eviction taints from a cluster.
+type RemoveEvictionTaintRequest struct {
+	Keys []string `json:"keys" binding:"required,min=1"`
+	// DryRun reports the affected workloads without changing the cluster.
+	DryRun bool `json:"dryRun"`
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// PostFederatedResourceQuotaRequest defines the request structure for creating a federated resource quota.
+type PostFederatedResourceQuotaRequest struct {
+	Namespace         string                                   `json:"namespace" binding:"required"`
+	Name              string                                   `json:"name" binding:"required"`
+	Overall           corev1.ResourceList                      `json:"overall" binding:"required"`
+	StaticAssignments []policyv1alpha1.StaticClusterAssignment `json:"staticAssignments"`
+}
+
+// PutFederatedResourceQuotaRequest defines the request structure for updating a federated resource quota.
+type PutFederatedResourceQuotaRequest struct {
+	Namespace         string                                   `json:"namespace" binding:"required"`
+	Name              string                                   `json:"name" binding:"required"`
+	Overall           corev1.ResourceList                      `json:"overall" binding:"required"`
+	StaticAssignments []policyv1alpha1.StaticClusterAssignment `json:"staticAssignments"`
+	// ResourceVersion is the version of the quota the client read, it is required and the update
+	// is rejected with a conflict if the quota has been modified since then.
+	ResourceVersion string `json:"resourceVersion" binding:"required"`
+}
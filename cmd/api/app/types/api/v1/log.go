@@ -0,0 +1,38 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import "time"
+
+// GetLogsRequest is the query of reading container logs.
+type GetLogsRequest struct {
+	// Container to read the logs of, defaults to the default container of the pod in single pod mode
+	// and to every container in workload mode.
+	Container string `form:"container"`
+	// Follow keeps streaming new lines.
+	Follow bool `form:"follow"`
+	// TailLines is the number of lines from the end of the logs to start from.
+	TailLines *int64 `form:"tailLines" binding:"omitempty,min=0"`
+	// SinceTime only returns the lines written after it, in RFC3339.
+	SinceTime *time.Time `form:"sinceTime" time_format:"2006-01-02T15:04:05Z07:00"`
+	// SinceSeconds only returns the lines written in the last seconds.
+	SinceSeconds *int64 `form:"sinceSeconds" binding:"omitempty,min=1"`
+	// Previous reads the logs of the previous terminated container.
+	Previous bool `form:"previous"`
+	// Timestamps prefixes every line with its timestamp.
+	Timestamps bool `form:"timestamps"`
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	networkingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/networking/v1alpha1"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+// MultiClusterServiceRequest defines the request structure for creating or updating a MultiClusterService.
+type MultiClusterServiceRequest struct {
+	Namespace string                                     `json:"namespace" binding:"required"`
+	Name      string                                     `json:"name" binding:"required"`
+	Spec      networkingv1alpha1.MultiClusterServiceSpec `json:"spec" binding:"required"`
+	// ResourceVersion is ignored on create and required on update, where it must be the version of
+	// the MultiClusterService the client read so that concurrent modifications are rejected with a conflict.
+	ResourceVersion string `json:"resourceVersion"`
+}
+
+// MultiClusterIngressRequest defines the request structure for creating or updating a MultiClusterIngress.
+type MultiClusterIngressRequest struct {
+	Namespace string                   `json:"namespace" binding:"required"`
+	Name      string                   `json:"name" binding:"required"`
+	Spec      networkingv1.IngressSpec `json:"spec" binding:"required"`
+	// ResourceVersion is ignored on create and required on update, where it must be the version of
+	// the MultiClusterIngress the client read so that concurrent modifications are rejected with a conflict.
+	ResourceVersion string `json:"resourceVersion"`
+}
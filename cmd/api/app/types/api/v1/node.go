@@ -0,0 +1,33 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// DrainNodeRequest is the request body for draining a node of a member cluster.
+type DrainNodeRequest struct {
+	// Force evicts pods that are not managed by a controller.
+	Force bool `json:"force"`
+	// IgnoreDaemonSets skips the pods managed by a DaemonSet.
+	IgnoreDaemonSets bool `json:"ignoreDaemonSets"`
+	// DeleteEmptyDirData evicts pods using emptyDir volumes.
+	DeleteEmptyDirData bool `json:"deleteEmptyDirData"`
+	// GracePeriodSeconds overrides the termination grace period of the pods.
+	GracePeriodSeconds *int `json:"gracePeriodSeconds"`
+	// TimeoutSeconds is how long to wait for the pods to be evicted, defaults to 120.
+	TimeoutSeconds int `json:"timeoutSeconds"`
+	// DryRun only reports the pods that would be evicted.
+	DryRun bool `json:"dryRun"`
+}
@@ -16,6 +16,10 @@ limitations under the License.
 
 package v1
 
+import (
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+)
+
 // PostOverridePolicyRequest is the request body for creating an override policy.
 type PostOverridePolicyRequest struct {
 	OverrideData   string `json:"overrideData" binding:"required"`
@@ -33,6 +37,9 @@ type PutOverridePolicyRequest struct {
 	IsClusterScope bool   `json:"isClusterScope"`
 	Namespace      string `json:"namespace"`
 	Name           string `json:"name" binding:"required"`
+	// ResourceVersion is the version of policy the client read, the one in the yaml is used when it's
+	// empty. It is required, the update is rejected with a conflict if the policy has been modified since then.
+	ResourceVersion string `json:"resourceVersion"`
 }
 
 // PutOverridePolicyResponse is the response body for updating an override policy.
@@ -44,8 +51,33 @@ type DeleteOverridePolicyRequest struct {
 	IsClusterScope bool   `json:"isClusterScope"`
 	Namespace      string `json:"namespace"`
 	Name           string `json:"name" binding:"required"`
+	// TimeoutSeconds is the time to wait for the policy to be removed, defaults to 30s.
+	TimeoutSeconds int `json:"timeoutSeconds"`
 }
 
 // DeleteOverridePolicyResponse is the response body for deleting an override policy.
 type DeleteOverridePolicyResponse struct {
 }
+
+// PreviewOverridePolicyRequest defines the request structure for previewing the effective manifest
+// of a resource template in a member cluster.
+type PreviewOverridePolicyRequest struct {
+	Kind      string `json:"kind" binding:"required"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name" binding:"required"`
+	Cluster   string `json:"cluster" binding:"required"`
+}
+
+// OverridePolicyForm defines the structured request for authoring an override policy, it mirrors
+// policyv1alpha1.OverridePolicy so that the policy can be built by form instead of raw yaml.
+type OverridePolicyForm struct {
+	IsClusterScope bool   `json:"isClusterScope"`
+	Namespace      string `json:"namespace"`
+	Name           string `json:"name"`
+	// ResourceVersion is required when updating, the update is rejected with a conflict if the policy
+	// has been modified since the client read it.
+	ResourceVersion string                      `json:"resourceVersion"`
+	Labels          map[string]string           `json:"labels"`
+	Annotations     map[string]string           `json:"annotations"`
+	Spec            policyv1alpha1.OverrideSpec `json:"spec"`
+}
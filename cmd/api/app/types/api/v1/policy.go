@@ -0,0 +1,53 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+)
+
+// GetPolicyListRequest defines the query of listing PropagationPolicies, OverridePolicies and the cluster
+// scoped ones.
+type GetPolicyListRequest struct {
+	// Analyze attaches the warnings of policy analysis to every policy, it lists all the policies and the
+	// resources they select, so it's only done on demand.
+	Analyze bool `form:"analyze"`
+}
+
+// ValidatePolicyResponse defines the response structure for validating a policy.
+type ValidatePolicyResponse struct {
+	Valid  bool                `json:"valid"`
+	Errors []errors.FieldError `json:"errors"`
+	// Spec is the spec of policy after defaulting.
+	Spec interface{} `json:"spec"`
+}
+
+// PutPolicyMetadataRequest defines the request structure for editing labels and annotations of a policy.
+type PutPolicyMetadataRequest struct {
+	IsClusterScope  bool              `json:"isClusterScope"`
+	Namespace       string            `json:"namespace"`
+	Name            string            `json:"name" binding:"required"`
+	ResourceVersion string            `json:"resourceVersion" binding:"required"`
+	Labels          map[string]string `json:"labels"`
+	Annotations     map[string]string `json:"annotations"`
+}
+
+// PolicyVersionResponse defines the response structure for a policy write, it carries the new
+// resourceVersion so that the client can issue further updates without re-reading the policy.
+type PolicyVersionResponse struct {
+	ResourceVersion string `json:"resourceVersion"`
+}
@@ -16,6 +16,10 @@ limitations under the License.
 
 package v1
 
+import (
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+)
+
 // PostPropagationPolicyRequest defines the request structure for creating a propagation policy.
 // todo this is only a simple version of pp request, just for POC
 type PostPropagationPolicyRequest struct {
@@ -34,6 +38,9 @@ type PutPropagationPolicyRequest struct {
 	IsClusterScope  bool   `json:"isClusterScope"`
 	Namespace       string `json:"namespace"`
 	Name            string `json:"name" binding:"required"`
+	// ResourceVersion is the version of policy the client read, the one in the yaml is used when it's
+	// empty. It is required, the update is rejected with a conflict if the policy has been modified since then.
+	ResourceVersion string `json:"resourceVersion"`
 }
 
 // PutPropagationPolicyResponse defines the response structure for updating a propagation policy.
@@ -45,8 +52,24 @@ type DeletePropagationPolicyRequest struct {
 	IsClusterScope bool   `json:"isClusterScope"`
 	Namespace      string `json:"namespace"`
 	Name           string `json:"name" binding:"required"`
+	// TimeoutSeconds is the time to wait for the policy to be removed, defaults to 30s.
+	TimeoutSeconds int `json:"timeoutSeconds"`
 }
 
 // DeletePropagationPolicyResponse defines the response structure for deleting a propagation policy.
 type DeletePropagationPolicyResponse struct {
 }
+
+// PropagationPolicyForm defines the structured request for authoring a propagation policy, it mirrors
+// policyv1alpha1.PropagationPolicy so that the policy can be built by form instead of raw yaml.
+type PropagationPolicyForm struct {
+	IsClusterScope bool   `json:"isClusterScope"`
+	Namespace      string `json:"namespace"`
+	Name           string `json:"name"`
+	// ResourceVersion is required when updating, the update is rejected with a conflict if the policy
+	// has been modified since the client read it.
+	ResourceVersion string                         `json:"resourceVersion"`
+	Labels          map[string]string              `json:"labels"`
+	Annotations     map[string]string              `json:"annotations"`
+	Spec            policyv1alpha1.PropagationSpec `json:"spec"`
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ResourceInterpreterCustomizationRequest defines the request structure for creating or updating a ResourceInterpreterCustomization.
+type ResourceInterpreterCustomizationRequest struct {
+	Name string                                              `json:"name" binding:"required"`
+	Spec configv1alpha1.ResourceInterpreterCustomizationSpec `json:"spec" binding:"required"`
+	// ResourceVersion is required when updating, the update is rejected with a conflict if the
+	// customization has changed since the client read it at this version.
+	ResourceVersion string `json:"resourceVersion"`
+}
+
+// ExecuteResourceInterpreterCustomizationRequest defines the request structure for running an operation of a
+// ResourceInterpreterCustomization against sample objects locally.
+type ExecuteResourceInterpreterCustomizationRequest struct {
+	// Name is the name of an existing ResourceInterpreterCustomization, it is ignored when Spec is set.
+	Name string `json:"name"`
+	// Spec is an unsaved ResourceInterpreterCustomization, used to try out scripts before creating it.
+	Spec *configv1alpha1.ResourceInterpreterCustomizationSpec `json:"spec"`
+	// Operation is the interpreter operation to run, e.g. InterpretReplica, the name is case-insensitive.
+	Operation string `json:"operation" binding:"required"`
+	// Desired is the object in karmada control-plane, used by Retain, ReviseReplica and AggregateStatus.
+	Desired *unstructured.Unstructured `json:"desired"`
+	// Observed is the object in member cluster, used by Retain and other operations interpreting the object.
+	Observed *unstructured.Unstructured `json:"observed"`
+	// Status are the statuses of the object in member clusters, used by AggregateStatus.
+	Status []workv1alpha2.AggregatedStatusItem `json:"status"`
+	// Replica is the replica number to revise to, used by ReviseReplica.
+	Replica int64 `json:"replica"`
+}
@@ -0,0 +1,31 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+)
+
+// ResourceInterpreterWebhookConfigurationRequest defines the request structure for creating or updating a
+// ResourceInterpreterWebhookConfiguration.
+type ResourceInterpreterWebhookConfigurationRequest struct {
+	Name     string                                      `json:"name" binding:"required"`
+	Webhooks []configv1alpha1.ResourceInterpreterWebhook `json:"webhooks" binding:"required"`
+	// ResourceVersion must be the version of the configuration the client read when updating,
+	// it is not used on create.
+	ResourceVersion string `json:"resourceVersion"`
+}
@@ -0,0 +1,34 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	searchv1alpha1 "github.com/karmada-io/karmada/pkg/apis/search/v1alpha1"
+)
+
+// ResourceRegistryRequest defines the request structure for creating or updating a ResourceRegistry.
+type ResourceRegistryRequest struct {
+	Name string `json:"name" binding:"required"`
+	// TargetCluster restricts the member clusters to cache from, all the clusters are cached when it's empty.
+	TargetCluster policyv1alpha1.ClusterAffinity `json:"targetCluster"`
+	// ResourceSelectors are the resources to be cached by karmada-search.
+	ResourceSelectors []searchv1alpha1.ResourceSelector `json:"resourceSelectors" binding:"required,min=1"`
+	// ResourceVersion is ignored on create, on update it is required and a stale version is
+	// rejected with a conflict.
+	ResourceVersion string `json:"resourceVersion"`
+}
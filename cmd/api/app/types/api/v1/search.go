@@ -0,0 +1,32 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// SearchRequest defines the request structure for searching resources across the control plane and
+// the member clusters.
+type SearchRequest struct {
+	// Name matches the resources whose name contains it, case insensitively.
+	Name          string   `form:"name"`
+	LabelSelector string   `form:"labelSelector"`
+	Kinds         []string `form:"kind"`
+	Namespace     string   `form:"namespace"`
+	// Clusters restricts the member clusters searched, all of them are searched when empty.
+	Clusters            []string `form:"cluster"`
+	ExcludeControlPlane bool     `form:"excludeControlPlane"`
+	Limit               int      `form:"limit" binding:"omitempty,min=1,max=5000"`
+	TimeoutSeconds      int      `form:"timeoutSeconds" binding:"omitempty,min=1,max=60"`
+}
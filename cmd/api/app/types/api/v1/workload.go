@@ -0,0 +1,51 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+)
+
+// ScaleWorkloadRequest is the request body for scaling a workload.
+type ScaleWorkloadRequest struct {
+	Replicas *int32 `json:"replicas" binding:"required,min=0"`
+	// DryRun only estimates how the replicas would be split among the member clusters.
+	DryRun bool `json:"dryRun"`
+}
+
+// CreateWorkloadRequest is the request body for creating a workload from yaml or json content.
+type CreateWorkloadRequest struct {
+	// Namespace defaults the namespace of the content, they must match if both are set.
+	Namespace string `json:"namespace"`
+	Content   string `json:"content" binding:"required"`
+	// PropagationPolicy is created along with the workload when set.
+	PropagationPolicy *WorkloadPropagationPolicy `json:"propagationPolicy"`
+}
+
+// WorkloadPropagationPolicy is a PropagationPolicy created along with a workload, it selects only the
+// workload so the resource selectors of the spec are ignored.
+type WorkloadPropagationPolicy struct {
+	// Name defaults to <workload name>-<kind>.
+	Name string                         `json:"name"`
+	Spec policyv1alpha1.PropagationSpec `json:"spec"`
+}
+
+// UpdateWorkloadRequest is the request body for updating a workload from yaml or json content, the content
+// must carry the metadata.resourceVersion the workload was read at.
+type UpdateWorkloadRequest struct {
+	Content string `json:"content" binding:"required"`
+}
@@ -0,0 +1,32 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	appsv1alpha1 "github.com/karmada-io/karmada/pkg/apis/apps/v1alpha1"
+)
+
+// WorkloadRebalancerRequest defines the request structure for creating or updating a WorkloadRebalancer.
+type WorkloadRebalancerRequest struct {
+	Name string `json:"name" binding:"required"`
+	// Workloads are the workloads to be rescheduled.
+	Workloads []appsv1alpha1.ObjectReference `json:"workloads" binding:"required,min=1"`
+	// TTLSecondsAfterFinished limits the lifetime of the WorkloadRebalancer after it has finished.
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished"`
+	// ResourceVersion is the version of the rebalancer the client read, it must be set when updating.
+	ResourceVersion string `json:"resourceVersion"`
+}
@@ -20,6 +20,7 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
 // BaseResponse is the base response
@@ -46,6 +47,10 @@ func Response(c *gin.Context, err error, data interface{}) {
 	if err != nil {
 		code = 500
 		message = err.Error()
+		// conflicts are surfaced as is, so that the client can tell a stale write and reload.
+		if k8serrors.IsConflict(err) {
+			code = http.StatusConflict
+		}
 	}
 	c.JSON(http.StatusOK, BaseResponse{
 		Code: code,
@@ -0,0 +1,101 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// WebSocketProtocol is the subprotocol the websocket endpoints answer with. Clients passing their
+	// token as a subprotocol must offer it as well, browsers require the server to select one.
+	WebSocketProtocol = "dashboard.karmada.io"
+	// streamWriteTimeout is how long writing a message to a websocket may block.
+	streamWriteTimeout = 10 * time.Second
+)
+
+var upgrader = websocket.Upgrader{
+	Subprotocols: []string{WebSocketProtocol},
+	// the API is served behind the same authentication as the other routes, the dashboard UI may be
+	// served from another origin.
+	CheckOrigin: func(_ *http.Request) bool { return true },
+}
+
+// UpgradeWebSocket upgrades the request to a websocket.
+func UpgradeWebSocket(c *gin.Context) (*websocket.Conn, error) {
+	return upgrader.Upgrade(c.Writer, c.Request, nil)
+}
+
+// StreamLines writes the lines formatted by format to the client until the channel is closed or the
+// client goes away, in which case cancel is called so that the producer stops. Each line is a text
+// message when the request is a websocket upgrade, otherwise the lines are written as a chunked plain
+// text response.
+func StreamLines[T any](c *gin.Context, cancel context.CancelFunc, lines <-chan T, format func(T) string) {
+	defer cancel()
+	if websocket.IsWebSocketUpgrade(c.Request) {
+		streamWebSocket(c, cancel, lines, format)
+		return
+	}
+
+	c.Header("Content-Type", "text/plain; charset=utf-8")
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Stream(func(w io.Writer) bool {
+		line, ok := <-lines
+		if !ok {
+			return false
+		}
+		if _, err := io.WriteString(w, format(line)+"\n"); err != nil {
+			return false
+		}
+		return true
+	})
+}
+
+func streamWebSocket[T any](c *gin.Context, cancel context.CancelFunc, lines <-chan T, format func(T) string) {
+	conn, err := UpgradeWebSocket(c)
+	if err != nil {
+		klog.ErrorS(err, "Failed to upgrade to websocket")
+		return
+	}
+	defer conn.Close()
+
+	// messages from the client are ignored, reading is only needed to notice it going away
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	for line := range lines {
+		_ = conn.SetWriteDeadline(time.Now().Add(streamWriteTimeout))
+		if err := conn.WriteMessage(websocket.TextMessage, []byte(format(line))); err != nil {
+			return
+		}
+	}
+	_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+		time.Now().Add(streamWriteTimeout))
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package client
 
 import (
+	"encoding/base64"
 	"net/http"
 	"strings"
 
@@ -31,6 +32,11 @@ const (
 	authorizationHeader = "Authorization"
 	// authorizationTokenPrefix is the default bearer token prefix.
 	authorizationTokenPrefix = "Bearer "
+	// webSocketProtocolHeader is the header listing the subprotocols offered by a websocket client.
+	webSocketProtocolHeader = "Sec-WebSocket-Protocol"
+	// webSocketTokenProtocolPrefix prefixes the base64url encoded bearer token passed as a websocket
+	// subprotocol, browsers cannot set the authorization header of websocket requests.
+	webSocketTokenProtocolPrefix = "base64url.bearer.authorization.k8s.io."
 )
 
 func karmadaConfigFromRequest(request *http.Request) (*rest.Config, error) {
@@ -99,6 +105,29 @@ func GetBearerToken(req *http.Request) string {
 	return extractBearerToken(header)
 }
 
+// SetAuthorizationHeaderFromWebSocketProtocol sets the authorization header of a websocket request
+// from the bearer token passed as a subprotocol, the same way the kube-apiserver accepts it. A request
+// with an authorization header is left untouched.
+func SetAuthorizationHeaderFromWebSocketProtocol(req *http.Request) {
+	if HasAuthorizationHeader(req) {
+		return
+	}
+	for _, header := range req.Header.Values(webSocketProtocolHeader) {
+		for _, protocol := range strings.Split(header, ",") {
+			protocol = strings.TrimSpace(protocol)
+			if !strings.HasPrefix(protocol, webSocketTokenProtocolPrefix) {
+				continue
+			}
+			token, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(protocol, webSocketTokenProtocolPrefix))
+			if err != nil || len(token) == 0 {
+				continue
+			}
+			SetAuthorizationHeader(req, string(token))
+			return
+		}
+	}
+}
+
 // SetAuthorizationHeader sets the authorization header for the given request.
 func SetAuthorizationHeader(req *http.Request, token string) {
 	req.Header.Set(authorizationHeader, authorizationTokenPrefix+token)
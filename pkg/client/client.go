@@ -125,3 +125,17 @@ func karmadaClientFromRequest(request *http.Request) (karmadaclientset.Interface
 
 	return karmadaclientset.NewForConfig(config)
 }
+
+// GetMemberClientFromRequest creates a kubernetes clientset for a member cluster from an HTTP request,
+// it accesses the member apiserver through the cluster proxy with the credentials of the user.
+func GetMemberClientFromRequest(request *http.Request, clusterName string) (kubeclient.Interface, error) {
+	if !isKarmadaInitialized() {
+		return nil, fmt.Errorf("client package not initialized")
+	}
+	config, err := karmadaConfigFromRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	config.Host += fmt.Sprintf(proxyURL, clusterName)
+	return kubeclient.NewForConfig(config)
+}
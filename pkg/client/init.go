@@ -23,10 +23,12 @@ import (
 	"sync"
 
 	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/util/gclient"
 	kubeclient "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"k8s.io/klog/v2"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const proxyURL = "/apis/cluster.karmada.io/v1alpha1/clusters/%s/proxy/"
@@ -40,8 +42,9 @@ var (
 	karmadaMemberConfig                *rest.Config
 	inClusterKarmadaClient             karmadaclientset.Interface
 	inClusterClientForKarmadaAPIServer kubeclient.Interface
-	inClusterClientForMemberAPIServer  kubeclient.Interface
+	inClusterRuntimeClient             ctrlclient.Client
 	memberClients                      sync.Map
+	memberRuntimeClients               sync.Map
 )
 
 type configBuilder struct {
@@ -282,6 +285,29 @@ func InClusterClientForKarmadaAPIServer() kubeclient.Interface {
 	return inClusterClientForKarmadaAPIServer
 }
 
+// InClusterRuntimeClientForKarmadaAPIServer returns a controller-runtime client for karmada apiserver,
+// it's used when reusing karmada libraries which rely on controller-runtime client.
+func InClusterRuntimeClientForKarmadaAPIServer() ctrlclient.Client {
+	if !isKarmadaInitialized() {
+		return nil
+	}
+	if inClusterRuntimeClient != nil {
+		return inClusterRuntimeClient
+	}
+	restConfig, _, err := GetKarmadaConfig()
+	if err != nil {
+		klog.ErrorS(err, "Could not get karmada restConfig")
+		return nil
+	}
+	c, err := gclient.NewForConfig(restConfig)
+	if err != nil {
+		klog.ErrorS(err, "Could not init controller-runtime client for karmada apiserver")
+		return nil
+	}
+	inClusterRuntimeClient = c
+	return inClusterRuntimeClient
+}
+
 // InClusterClientForMemberCluster returns a kubernetes client for member apiserver.
 func InClusterClientForMemberCluster(clusterName string) kubeclient.Interface {
 	if !isKarmadaInitialized() {
@@ -290,33 +316,65 @@ func InClusterClientForMemberCluster(clusterName string) kubeclient.Interface {
 
 	// Load and return Interface for member apiserver if already exist
 	if value, ok := memberClients.Load(clusterName); ok {
-		if inClusterClientForMemberAPIServer, ok = value.(kubeclient.Interface); ok {
-			return inClusterClientForMemberAPIServer
+		if c, ok := value.(kubeclient.Interface); ok {
+			return c
 		}
 		klog.Error("Could not get client for member apiserver")
 		return nil
 	}
 
 	// Client for new member apiserver
-	restConfig, _, err := GetKarmadaConfig()
+	memberConfig, err := GetMemberConfigForCluster(clusterName)
 	if err != nil {
-		klog.ErrorS(err, "Could not get karmada restConfig")
+		klog.ErrorS(err, "Could not get member restConfig")
 		return nil
 	}
+	c, err := kubeclient.NewForConfig(memberConfig)
+	if err != nil {
+		klog.ErrorS(err, "Could not init kubernetes in-cluster client for member apiserver")
+		return nil
+	}
+	value, _ := memberClients.LoadOrStore(clusterName, c)
+	return value.(kubeclient.Interface)
+}
+
+// GetMemberConfigForCluster returns a copy of member client config which accesses the member
+// apiserver through the cluster proxy of karmada apiserver.
+func GetMemberConfigForCluster(clusterName string) (*rest.Config, error) {
+	restConfig, _, err := GetKarmadaConfig()
+	if err != nil {
+		return nil, err
+	}
 	memberConfig, err := GetMemberConfig()
+	if err != nil {
+		return nil, err
+	}
+	config := rest.CopyConfig(memberConfig)
+	config.Host = restConfig.Host + fmt.Sprintf(proxyURL, clusterName)
+	return config, nil
+}
+
+// InClusterRuntimeClientForMemberCluster returns a controller-runtime client for member apiserver,
+// it's used to access non-builtin resources of member clusters, like ServiceExport and ServiceImport.
+func InClusterRuntimeClientForMemberCluster(clusterName string) ctrlclient.Client {
+	if !isKarmadaInitialized() {
+		return nil
+	}
+	if value, ok := memberRuntimeClients.Load(clusterName); ok {
+		return value.(ctrlclient.Client)
+	}
+	memberConfig, err := GetMemberConfigForCluster(clusterName)
 	if err != nil {
 		klog.ErrorS(err, "Could not get member restConfig")
 		return nil
 	}
-	memberConfig.Host = restConfig.Host + fmt.Sprintf(proxyURL, clusterName)
-	c, err := kubeclient.NewForConfig(memberConfig)
+	c, err := gclient.NewForConfig(memberConfig)
 	if err != nil {
-		klog.ErrorS(err, "Could not init kubernetes in-cluster client for member apiserver")
+		klog.ErrorS(err, "Could not init controller-runtime client for member apiserver")
 		return nil
 	}
-	inClusterClientForMemberAPIServer = c
-	memberClients.Store(clusterName, inClusterClientForMemberAPIServer)
-	return inClusterClientForMemberAPIServer
+	memberRuntimeClients.Store(clusterName, c)
+	return c
 }
 
 // ConvertRestConfigToAPIConfig converts a rest.Config to a clientcmdapi.Config.
@@ -0,0 +1,74 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"sync"
+
+	"k8s.io/client-go/metadata"
+	"k8s.io/klog/v2"
+)
+
+var (
+	inClusterMetadataClient metadata.Interface
+	memberMetadataClients   sync.Map
+)
+
+// InClusterMetadataClientForKarmadaAPIServer returns a metadata client for karmada apiserver, it lists
+// any kind of resource by its object metadata only.
+func InClusterMetadataClientForKarmadaAPIServer() metadata.Interface {
+	if !isKarmadaInitialized() {
+		return nil
+	}
+	if inClusterMetadataClient != nil {
+		return inClusterMetadataClient
+	}
+	restConfig, _, err := GetKarmadaConfig()
+	if err != nil {
+		klog.ErrorS(err, "Could not get karmada restConfig")
+		return nil
+	}
+	c, err := metadata.NewForConfig(restConfig)
+	if err != nil {
+		klog.ErrorS(err, "Could not init metadata client for karmada apiserver")
+		return nil
+	}
+	inClusterMetadataClient = c
+	return inClusterMetadataClient
+}
+
+// InClusterMetadataClientForMemberCluster returns a metadata client for member apiserver.
+func InClusterMetadataClientForMemberCluster(clusterName string) metadata.Interface {
+	if !isKarmadaInitialized() {
+		return nil
+	}
+	if value, ok := memberMetadataClients.Load(clusterName); ok {
+		return value.(metadata.Interface)
+	}
+	memberConfig, err := GetMemberConfigForCluster(clusterName)
+	if err != nil {
+		klog.ErrorS(err, "Could not get member restConfig")
+		return nil
+	}
+	c, err := metadata.NewForConfig(memberConfig)
+	if err != nil {
+		klog.ErrorS(err, "Could not init metadata client for member apiserver")
+		return nil
+	}
+	memberMetadataClients.Store(clusterName, c)
+	return c
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"sync"
+	"time"
+
+	searchv1alpha1 "github.com/karmada-io/karmada/pkg/apis/search/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/discovery"
+	kubeclient "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+)
+
+// searchProxyURL is the path of karmada apiserver proxying requests to karmada-search, lists of the resources
+// cached by a ResourceRegistry are served from the cache of all the member clusters at once.
+const searchProxyURL = "/apis/search.karmada.io/v1alpha1/proxying/karmada/proxy"
+
+// searchDetectionInterval is how long the detection of karmada-search is cached, so that installing or
+// removing it is noticed without a restart.
+const searchDetectionInterval = time.Minute
+
+var (
+	searchDetectionLock    sync.Mutex
+	searchEnabled          bool
+	searchDetectedAt       time.Time
+	inClusterSearchClient  kubeclient.Interface
+	searchProxyClientsLock sync.Mutex
+)
+
+// IsKarmadaSearchEnabled reports whether karmada-search is installed, that is karmada apiserver serves
+// the search.karmada.io API. The answer is cached for a while.
+func IsKarmadaSearchEnabled() bool {
+	if !isKarmadaInitialized() {
+		return false
+	}
+	searchDetectionLock.Lock()
+	defer searchDetectionLock.Unlock()
+	if !searchDetectedAt.IsZero() && time.Since(searchDetectedAt) < searchDetectionInterval {
+		return searchEnabled
+	}
+	karmadaClient := InClusterClientForKarmadaAPIServer()
+	if karmadaClient == nil {
+		return false
+	}
+	enabled, err := isSearchServed(karmadaClient.Discovery())
+	if err != nil {
+		// keep the previous answer on a transient failure, it's checked again next time
+		klog.ErrorS(err, "Could not detect karmada-search")
+		return searchEnabled
+	}
+	if enabled != searchEnabled {
+		klog.InfoS("Detected karmada-search", "enabled", enabled)
+	}
+	searchEnabled, searchDetectedAt = enabled, time.Now()
+	return searchEnabled
+}
+
+func isSearchServed(client discovery.DiscoveryInterface) (bool, error) {
+	resources, err := client.ServerResourcesForGroupVersion(searchv1alpha1.SchemeGroupVersion.String())
+	if errors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	for _, resource := range resources.APIResources {
+		if resource.Name == searchv1alpha1.ResourcePluralResourceRegistry {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// InClusterClientForKarmadaSearchProxy returns a kubernetes client whose requests go through the proxy of
+// karmada-search. Lists of the resources cached by a ResourceRegistry aggregate all the member clusters in
+// one call, every item carries the cluster it comes from in the resource.karmada.io/cached-from-cluster
+// annotation, the other requests are served by karmada apiserver. nil is returned when karmada-search is
+// not installed.
+func InClusterClientForKarmadaSearchProxy() kubeclient.Interface {
+	if !IsKarmadaSearchEnabled() {
+		return nil
+	}
+	searchProxyClientsLock.Lock()
+	defer searchProxyClientsLock.Unlock()
+	if inClusterSearchClient != nil {
+		return inClusterSearchClient
+	}
+	restConfig, _, err := GetKarmadaConfig()
+	if err != nil {
+		klog.ErrorS(err, "Could not get karmada restConfig")
+		return nil
+	}
+	config := rest.CopyConfig(restConfig)
+	config.Host += searchProxyURL
+	c, err := kubeclient.NewForConfig(config)
+	if err != nil {
+		klog.ErrorS(err, "Could not init kubernetes client for karmada-search proxy")
+		return nil
+	}
+	inClusterSearchClient = c
+	return inClusterSearchClient
+}
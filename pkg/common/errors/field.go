@@ -0,0 +1,42 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// FieldError is a presentation layer view of a validation error for a single field, Field is the
+// path of the invalid field, e.g. spec.placement.clusterAffinity.
+type FieldError struct {
+	Field  string          `json:"field"`
+	Type   field.ErrorType `json:"type"`
+	Detail string          `json:"detail"`
+}
+
+// ToFieldErrors converts a field.ErrorList into a list of FieldError which can be returned to frontend.
+func ToFieldErrors(errs field.ErrorList) []FieldError {
+	fieldErrors := make([]FieldError, 0, len(errs))
+	for _, err := range errs {
+		fieldErrors = append(fieldErrors, FieldError{
+			Field:  err.Field,
+			Type:   err.Type,
+			Detail: err.ErrorBody(),
+		})
+	}
+	return fieldErrors
+}
@@ -107,6 +107,7 @@ func GetDashboardConfig() DashboardConfig {
 		ChartRegistries:  dashboardConfig.ChartRegistries,
 		MenuConfigs:      dashboardConfig.MenuConfigs,
 		PathPrefix:       dashboardConfig.PathPrefix,
+		Terminal:         dashboardConfig.Terminal,
 	}
 }
 
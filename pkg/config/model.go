@@ -16,6 +16,8 @@ limitations under the License.
 
 package config
 
+import "strings"
+
 // DockerRegistry represents a Docker registry configuration.
 type DockerRegistry struct {
 	Name     string `yaml:"name" json:"name"`
@@ -25,6 +27,12 @@ type DockerRegistry struct {
 	AddTime  int64  `yaml:"add_time" json:"add_time"`
 }
 
+// Host returns the host of the registry that prefixes the images pulled from it.
+func (r *DockerRegistry) Host() string {
+	host := strings.TrimPrefix(strings.TrimPrefix(r.URL, "https://"), "http://")
+	return strings.TrimSuffix(host, "/")
+}
+
 // ChartRegistry represents a Helm chart registry configuration.
 type ChartRegistry struct {
 	Name     string `yaml:"name" json:"name"`
@@ -42,10 +50,23 @@ type MenuConfig struct {
 	Children   []MenuConfig `yaml:"children" json:"children,omitempty"`
 }
 
+// TerminalConfig represents the configuration of the web terminals.
+type TerminalConfig struct {
+	// Registry is the name of a configured docker registry the image is pulled from, the image is used as is when empty.
+	Registry string `yaml:"registry" json:"registry"`
+	// Image is the image of the terminal pods, relative to the registry.
+	Image string `yaml:"image" json:"image"`
+	// MaxSessionsPerUser is the number of terminals a user may have at the same time.
+	MaxSessionsPerUser int `yaml:"max_sessions_per_user" json:"max_sessions_per_user"`
+	// IdleTimeoutMinutes is how long a terminal nobody is attached to is kept.
+	IdleTimeoutMinutes int `yaml:"idle_timeout_minutes" json:"idle_timeout_minutes"`
+}
+
 // DashboardConfig represents the configuration structure for the Karmada dashboard.
 type DashboardConfig struct {
 	DockerRegistries []DockerRegistry `yaml:"docker_registries" json:"docker_registries"`
 	ChartRegistries  []ChartRegistry  `yaml:"chart_registries" json:"chart_registries"`
 	MenuConfigs      []MenuConfig     `yaml:"menu_configs" json:"menu_configs"`
 	PathPrefix       string           `yaml:"path_prefix" json:"path_prefix"`
+	Terminal         TerminalConfig   `yaml:"terminal" json:"terminal"`
 }
@@ -0,0 +1,53 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+
+	"k8s.io/klog/v2"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Apply creates the manifests of an app on the karmada control plane. All of them are validated by a dry
+// run first, and those already created are removed again if one can't be created, so that a failure
+// doesn't leave half an app behind.
+func Apply(ctx context.Context, c ctrlclient.Client, manifests *Manifests) error {
+	objects := manifests.Objects()
+	for _, obj := range objects {
+		if err := c.Create(ctx, obj.DeepCopyObject().(ctrlclient.Object), ctrlclient.DryRunAll); err != nil {
+			return err
+		}
+	}
+	for i, obj := range objects {
+		if err := c.Create(ctx, obj); err != nil {
+			rollback(ctx, c, objects[:i])
+			return err
+		}
+	}
+	return nil
+}
+
+func rollback(ctx context.Context, c ctrlclient.Client, created []ctrlclient.Object) {
+	for i := len(created) - 1; i >= 0; i-- {
+		obj := created[i]
+		if err := c.Delete(ctx, obj); ctrlclient.IgnoreNotFound(err) != nil {
+			klog.ErrorS(err, "Failed to remove the manifest of a failed app", "kind", obj.GetObjectKind().GroupVersionKind().Kind,
+				"namespace", obj.GetNamespace(), "name", obj.GetName())
+		}
+	}
+}
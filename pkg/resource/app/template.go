@@ -0,0 +1,602 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/config"
+	"github.com/karmada-io/dashboard/pkg/resource/overridepolicy"
+	"github.com/karmada-io/dashboard/pkg/resource/propagationpolicy"
+)
+
+// NameLabel is the label selecting the pods of an app.
+const NameLabel = "app.kubernetes.io/name"
+
+// ProbeType is how a probe checks the container.
+type ProbeType string
+
+const (
+	// ProbeTypeHTTP performs an HTTP GET on the path and port of the probe.
+	ProbeTypeHTTP ProbeType = "http"
+	// ProbeTypeTCP opens a TCP connection to the port of the probe.
+	ProbeTypeTCP ProbeType = "tcp"
+	// ProbeTypeExec runs the command of the probe in the container.
+	ProbeTypeExec ProbeType = "exec"
+)
+
+// Spec is a high level description of an app, it's turned into a Deployment, a Service, an optional Ingress
+// and the policies propagating them to the member clusters.
+type Spec struct {
+	Name      string            `json:"name" binding:"required"`
+	Namespace string            `json:"namespace"`
+	Labels    map[string]string `json:"labels"`
+	// Registry is the name of a configured docker registry prefixing the image, the image is used as is when empty.
+	Registry         string   `json:"registry"`
+	Image            string   `json:"image" binding:"required"`
+	ImagePullSecrets []string `json:"imagePullSecrets"`
+	// Replicas in every member cluster, defaults to 1.
+	Replicas       *int32             `json:"replicas"`
+	Command        []string           `json:"command"`
+	Args           []string           `json:"args"`
+	Ports          []Port             `json:"ports"`
+	Env            []EnvVar           `json:"env"`
+	EnvFrom        []EnvSource        `json:"envFrom"`
+	Resources      Resources          `json:"resources"`
+	LivenessProbe  *Probe             `json:"livenessProbe"`
+	ReadinessProbe *Probe             `json:"readinessProbe"`
+	ServiceType    corev1.ServiceType `json:"serviceType"`
+	Ingress        *Ingress           `json:"ingress"`
+	Clusters       []Cluster          `json:"clusters" binding:"required,min=1"`
+}
+
+// Port is a port the container listens on, it's exposed by the Service of the app.
+type Port struct {
+	Name          string `json:"name"`
+	ContainerPort int32  `json:"containerPort"`
+	// ServicePort defaults to the container port.
+	ServicePort int32           `json:"servicePort"`
+	Protocol    corev1.Protocol `json:"protocol"`
+}
+
+// EnvVar is an environment variable of the container, with a literal value or the key of a ConfigMap or Secret.
+type EnvVar struct {
+	Name      string  `json:"name"`
+	Value     string  `json:"value"`
+	ValueFrom *EnvRef `json:"valueFrom"`
+}
+
+// EnvRef references the key of a ConfigMap or Secret.
+type EnvRef struct {
+	Kind types.ResourceKind `json:"kind"`
+	Name string             `json:"name"`
+	Key  string             `json:"key"`
+}
+
+// EnvSource exposes all the keys of a ConfigMap or Secret as environment variables.
+type EnvSource struct {
+	Kind   types.ResourceKind `json:"kind"`
+	Name   string             `json:"name"`
+	Prefix string             `json:"prefix"`
+}
+
+// Resources are the compute resources of the container, in the quantity format of kubernetes.
+type Resources struct {
+	CPURequest    string `json:"cpuRequest"`
+	CPULimit      string `json:"cpuLimit"`
+	MemoryRequest string `json:"memoryRequest"`
+	MemoryLimit   string `json:"memoryLimit"`
+}
+
+// Probe is a liveness or readiness probe of the container.
+type Probe struct {
+	Type                ProbeType `json:"type"`
+	Path                string    `json:"path"`
+	Port                int32     `json:"port"`
+	Command             []string  `json:"command"`
+	InitialDelaySeconds int32     `json:"initialDelaySeconds"`
+	PeriodSeconds       int32     `json:"periodSeconds"`
+	TimeoutSeconds      int32     `json:"timeoutSeconds"`
+	FailureThreshold    int32     `json:"failureThreshold"`
+}
+
+// Ingress routes the requests of a host and path to the Service of the app.
+type Ingress struct {
+	ClassName string `json:"className"`
+	Host      string `json:"host"`
+	Path      string `json:"path"`
+	// ServicePort defaults to the first port of the Service.
+	ServicePort int32 `json:"servicePort"`
+	// TLSSecret enables TLS for the host with the certificate of the Secret.
+	TLSSecret string `json:"tlsSecret"`
+}
+
+// Cluster is a member cluster the app is propagated to, the replicas and image can differ per cluster.
+type Cluster struct {
+	Name     string `json:"name"`
+	Replicas *int32 `json:"replicas"`
+	// Image is relative to the registry of the app like the image of the app.
+	Image string `json:"image"`
+}
+
+// Manifests are the objects generated for an app.
+type Manifests struct {
+	Deployment        *appsv1.Deployment                `json:"deployment"`
+	Service           *corev1.Service                   `json:"service,omitempty"`
+	Ingress           *networkingv1.Ingress             `json:"ingress,omitempty"`
+	PropagationPolicy *policyv1alpha1.PropagationPolicy `json:"propagationPolicy"`
+	OverridePolicies  []*policyv1alpha1.OverridePolicy  `json:"overridePolicies"`
+	// YAML is all the manifests in a multi-document yaml, for review.
+	YAML string `json:"yaml"`
+}
+
+// Objects returns the manifests in the order they are created, the policies go first so that the workloads
+// are claimed by them right away.
+func (m *Manifests) Objects() []ctrlclient.Object {
+	objects := []ctrlclient.Object{m.PropagationPolicy}
+	for _, op := range m.OverridePolicies {
+		objects = append(objects, op)
+	}
+	objects = append(objects, m.Deployment)
+	if m.Service != nil {
+		objects = append(objects, m.Service)
+	}
+	if m.Ingress != nil {
+		objects = append(objects, m.Ingress)
+	}
+	return objects
+}
+
+// Generate turns the spec of an app into its manifests, images are prefixed with the host of the registry
+// of the spec, which must be one of registries.
+func Generate(spec Spec, registries []config.DockerRegistry) (*Manifests, error) {
+	if spec.Namespace == "" {
+		spec.Namespace = metav1.NamespaceDefault
+	}
+	if spec.Replicas == nil {
+		replicas := int32(1)
+		spec.Replicas = &replicas
+	}
+	if spec.ServiceType == "" {
+		spec.ServiceType = corev1.ServiceTypeClusterIP
+	}
+	for i := range spec.Ports {
+		if spec.Ports[i].ServicePort == 0 {
+			spec.Ports[i].ServicePort = spec.Ports[i].ContainerPort
+		}
+		if spec.Ports[i].Protocol == "" {
+			spec.Ports[i].Protocol = corev1.ProtocolTCP
+		}
+	}
+
+	registry, errs := validateSpec(&spec, registries)
+	resources, resourceErrs := toResourceRequirements(spec.Resources, field.NewPath("resources"))
+	errs = append(errs, resourceErrs...)
+	if len(errs) != 0 {
+		return nil, k8serrors.NewInvalid(schema.GroupKind{Kind: "App"}, spec.Name, errs)
+	}
+
+	labels := map[string]string{}
+	for k, v := range spec.Labels {
+		labels[k] = v
+	}
+	labels[NameLabel] = spec.Name
+	manifests := &Manifests{
+		Deployment:       newDeployment(spec, labels, image(registry, spec.Image), resources),
+		OverridePolicies: []*policyv1alpha1.OverridePolicy{},
+	}
+	if len(spec.Ports) > 0 {
+		manifests.Service = newService(spec, labels)
+	}
+	if spec.Ingress != nil {
+		manifests.Ingress = newIngress(spec, labels)
+	}
+	manifests.PropagationPolicy = newPropagationPolicy(spec, labels, manifests)
+	for _, cluster := range spec.Clusters {
+		if op := newOverridePolicy(spec, labels, registry, cluster); op != nil {
+			manifests.OverridePolicies = append(manifests.OverridePolicies, op)
+		}
+	}
+
+	if errs = propagationpolicy.ValidatePropagationPolicy(manifests.PropagationPolicy.Name, spec.Namespace,
+		&manifests.PropagationPolicy.Spec); len(errs) != 0 {
+		return nil, k8serrors.NewInvalid(schema.GroupKind{Kind: "App"}, spec.Name, errs)
+	}
+	for _, op := range manifests.OverridePolicies {
+		if errs = overridepolicy.ValidateOverridePolicy(op.Name, spec.Namespace, &op.Spec); len(errs) != 0 {
+			return nil, k8serrors.NewInvalid(schema.GroupKind{Kind: "App"}, spec.Name, errs)
+		}
+	}
+
+	documents := make([]string, 0, len(manifests.Objects()))
+	for _, obj := range manifests.Objects() {
+		content, err := yaml.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+		documents = append(documents, string(content))
+	}
+	manifests.YAML = strings.Join(documents, "---\n")
+	return manifests, nil
+}
+
+func validateSpec(spec *Spec, registries []config.DockerRegistry) (*config.DockerRegistry, field.ErrorList) {
+	var allErrs field.ErrorList
+	// the name is shared by the Deployment and the Service, whose names are DNS-1035 labels
+	for _, msg := range validation.IsDNS1035Label(spec.Name) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("name"), spec.Name, msg))
+	}
+	var registry *config.DockerRegistry
+	if spec.Registry != "" {
+		for i := range registries {
+			if registries[i].Name == spec.Registry {
+				registry = &registries[i]
+				break
+			}
+		}
+		if registry == nil {
+			allErrs = append(allErrs, field.NotFound(field.NewPath("registry"), spec.Registry))
+		}
+	}
+	if *spec.Replicas < 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("replicas"), *spec.Replicas, "must be greater than or equal to 0"))
+	}
+
+	portsPath := field.NewPath("ports")
+	for i, port := range spec.Ports {
+		for _, msg := range validation.IsValidPortNum(int(port.ContainerPort)) {
+			allErrs = append(allErrs, field.Invalid(portsPath.Index(i).Child("containerPort"), port.ContainerPort, msg))
+		}
+		for _, msg := range validation.IsValidPortNum(int(port.ServicePort)) {
+			allErrs = append(allErrs, field.Invalid(portsPath.Index(i).Child("servicePort"), port.ServicePort, msg))
+		}
+		if port.Name == "" && len(spec.Ports) > 1 {
+			allErrs = append(allErrs, field.Required(portsPath.Index(i).Child("name"), "ports must be named when there are several"))
+		}
+	}
+	for i, env := range spec.Env {
+		if env.Name == "" {
+			allErrs = append(allErrs, field.Required(field.NewPath("env").Index(i).Child("name"), ""))
+		}
+		if env.ValueFrom != nil {
+			allErrs = append(allErrs, validateEnvKind(field.NewPath("env").Index(i).Child("valueFrom", "kind"), env.ValueFrom.Kind)...)
+		}
+	}
+	for i, source := range spec.EnvFrom {
+		allErrs = append(allErrs, validateEnvKind(field.NewPath("envFrom").Index(i).Child("kind"), source.Kind)...)
+	}
+	allErrs = append(allErrs, validateProbe(field.NewPath("livenessProbe"), spec.LivenessProbe)...)
+	allErrs = append(allErrs, validateProbe(field.NewPath("readinessProbe"), spec.ReadinessProbe)...)
+	if spec.Ingress != nil && len(spec.Ports) == 0 {
+		allErrs = append(allErrs, field.Required(portsPath, "the ingress routes to the service, which needs a port"))
+	}
+
+	clustersPath := field.NewPath("clusters")
+	seen := map[string]bool{}
+	for i, cluster := range spec.Clusters {
+		if cluster.Name == "" {
+			allErrs = append(allErrs, field.Required(clustersPath.Index(i).Child("name"), ""))
+		} else if seen[cluster.Name] {
+			allErrs = append(allErrs, field.Duplicate(clustersPath.Index(i).Child("name"), cluster.Name))
+		}
+		seen[cluster.Name] = true
+		if cluster.Replicas != nil && *cluster.Replicas < 0 {
+			allErrs = append(allErrs, field.Invalid(clustersPath.Index(i).Child("replicas"), *cluster.Replicas, "must be greater than or equal to 0"))
+		}
+	}
+	return registry, allErrs
+}
+
+func validateEnvKind(path *field.Path, kind types.ResourceKind) field.ErrorList {
+	if kind != types.ResourceKindConfigMap && kind != types.ResourceKindSecret {
+		return field.ErrorList{field.NotSupported(path, kind, []string{types.ResourceKindConfigMap, types.ResourceKindSecret})}
+	}
+	return nil
+}
+
+func validateProbe(path *field.Path, probe *Probe) field.ErrorList {
+	if probe == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+	switch probe.Type {
+	case ProbeTypeHTTP, ProbeTypeTCP:
+		for _, msg := range validation.IsValidPortNum(int(probe.Port)) {
+			allErrs = append(allErrs, field.Invalid(path.Child("port"), probe.Port, msg))
+		}
+	case ProbeTypeExec:
+		if len(probe.Command) == 0 {
+			allErrs = append(allErrs, field.Required(path.Child("command"), ""))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(path.Child("type"), probe.Type,
+			[]string{string(ProbeTypeHTTP), string(ProbeTypeTCP), string(ProbeTypeExec)}))
+	}
+	return allErrs
+}
+
+func toResourceRequirements(resources Resources, path *field.Path) (corev1.ResourceRequirements, field.ErrorList) {
+	var allErrs field.ErrorList
+	requirements := corev1.ResourceRequirements{}
+	set := func(list *corev1.ResourceList, name corev1.ResourceName, value, child string) {
+		if value == "" {
+			return
+		}
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child(child), value, err.Error()))
+			return
+		}
+		if *list == nil {
+			*list = corev1.ResourceList{}
+		}
+		(*list)[name] = quantity
+	}
+	set(&requirements.Requests, corev1.ResourceCPU, resources.CPURequest, "cpuRequest")
+	set(&requirements.Limits, corev1.ResourceCPU, resources.CPULimit, "cpuLimit")
+	set(&requirements.Requests, corev1.ResourceMemory, resources.MemoryRequest, "memoryRequest")
+	set(&requirements.Limits, corev1.ResourceMemory, resources.MemoryLimit, "memoryLimit")
+	return requirements, allErrs
+}
+
+func image(registry *config.DockerRegistry, image string) string {
+	if registry == nil {
+		return image
+	}
+	return registry.Host() + "/" + image
+}
+
+func newDeployment(spec Spec, labels map[string]string, image string, resources corev1.ResourceRequirements) *appsv1.Deployment {
+	container := corev1.Container{
+		Name:           spec.Name,
+		Image:          image,
+		Command:        spec.Command,
+		Args:           spec.Args,
+		Resources:      resources,
+		LivenessProbe:  toProbe(spec.LivenessProbe),
+		ReadinessProbe: toProbe(spec.ReadinessProbe),
+	}
+	for _, port := range spec.Ports {
+		container.Ports = append(container.Ports, corev1.ContainerPort{
+			Name:          port.Name,
+			ContainerPort: port.ContainerPort,
+			Protocol:      port.Protocol,
+		})
+	}
+	for _, env := range spec.Env {
+		envVar := corev1.EnvVar{Name: env.Name, Value: env.Value}
+		if ref := env.ValueFrom; ref != nil {
+			envVar.Value = ""
+			if ref.Kind == types.ResourceKindSecret {
+				envVar.ValueFrom = &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: ref.Name}, Key: ref.Key}}
+			} else {
+				envVar.ValueFrom = &corev1.EnvVarSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: ref.Name}, Key: ref.Key}}
+			}
+		}
+		container.Env = append(container.Env, envVar)
+	}
+	for _, source := range spec.EnvFrom {
+		envFrom := corev1.EnvFromSource{Prefix: source.Prefix}
+		if source.Kind == types.ResourceKindSecret {
+			envFrom.SecretRef = &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: source.Name}}
+		} else {
+			envFrom.ConfigMapRef = &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: source.Name}}
+		}
+		container.EnvFrom = append(container.EnvFrom, envFrom)
+	}
+
+	podSpec := corev1.PodSpec{Containers: []corev1.Container{container}}
+	for _, secret := range spec.ImagePullSecrets {
+		podSpec.ImagePullSecrets = append(podSpec.ImagePullSecrets, corev1.LocalObjectReference{Name: secret})
+	}
+	return &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: appsv1.SchemeGroupVersion.String(), Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: spec.Namespace, Name: spec.Name, Labels: labels},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: spec.Replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{NameLabel: spec.Name}},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec:       podSpec,
+			},
+		},
+	}
+}
+
+func toProbe(probe *Probe) *corev1.Probe {
+	if probe == nil {
+		return nil
+	}
+	result := &corev1.Probe{
+		InitialDelaySeconds: probe.InitialDelaySeconds,
+		PeriodSeconds:       probe.PeriodSeconds,
+		TimeoutSeconds:      probe.TimeoutSeconds,
+		FailureThreshold:    probe.FailureThreshold,
+	}
+	switch probe.Type {
+	case ProbeTypeHTTP:
+		path := probe.Path
+		if path == "" {
+			path = "/"
+		}
+		result.HTTPGet = &corev1.HTTPGetAction{Path: path, Port: intstr.FromInt32(probe.Port)}
+	case ProbeTypeTCP:
+		result.TCPSocket = &corev1.TCPSocketAction{Port: intstr.FromInt32(probe.Port)}
+	case ProbeTypeExec:
+		result.Exec = &corev1.ExecAction{Command: probe.Command}
+	}
+	return result
+}
+
+func newService(spec Spec, labels map[string]string) *corev1.Service {
+	service := &corev1.Service{
+		TypeMeta:   metav1.TypeMeta{APIVersion: corev1.SchemeGroupVersion.String(), Kind: "Service"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: spec.Namespace, Name: spec.Name, Labels: labels},
+		Spec: corev1.ServiceSpec{
+			Type:     spec.ServiceType,
+			Selector: map[string]string{NameLabel: spec.Name},
+		},
+	}
+	for _, port := range spec.Ports {
+		targetPort := intstr.FromInt32(port.ContainerPort)
+		if port.Name != "" {
+			targetPort = intstr.FromString(port.Name)
+		}
+		service.Spec.Ports = append(service.Spec.Ports, corev1.ServicePort{
+			Name:       port.Name,
+			Port:       port.ServicePort,
+			TargetPort: targetPort,
+			Protocol:   port.Protocol,
+		})
+	}
+	return service
+}
+
+func newIngress(spec Spec, labels map[string]string) *networkingv1.Ingress {
+	port := spec.Ingress.ServicePort
+	if port == 0 {
+		port = spec.Ports[0].ServicePort
+	}
+	path := spec.Ingress.Path
+	if path == "" {
+		path = "/"
+	}
+	pathType := networkingv1.PathTypePrefix
+	ingress := &networkingv1.Ingress{
+		TypeMeta:   metav1.TypeMeta{APIVersion: networkingv1.SchemeGroupVersion.String(), Kind: "Ingress"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: spec.Namespace, Name: spec.Name, Labels: labels},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{{
+				Host: spec.Ingress.Host,
+				IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+					Paths: []networkingv1.HTTPIngressPath{{
+						Path:     path,
+						PathType: &pathType,
+						Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{
+							Name: spec.Name,
+							Port: networkingv1.ServiceBackendPort{Number: port},
+						}},
+					}},
+				}},
+			}},
+		},
+	}
+	if spec.Ingress.ClassName != "" {
+		ingress.Spec.IngressClassName = &spec.Ingress.ClassName
+	}
+	if spec.Ingress.TLSSecret != "" {
+		ingress.Spec.TLS = []networkingv1.IngressTLS{{Hosts: []string{spec.Ingress.Host}, SecretName: spec.Ingress.TLSSecret}}
+	}
+	return ingress
+}
+
+// newPropagationPolicy propagates the workloads of the app to its clusters. Replicas are duplicated so that
+// every cluster runs the replicas of the app unless overridden, and the ConfigMaps and Secrets the pods
+// depend on are propagated along.
+func newPropagationPolicy(spec Spec, labels map[string]string, manifests *Manifests) *policyv1alpha1.PropagationPolicy {
+	selectors := []policyv1alpha1.ResourceSelector{selectorFor(manifests.Deployment.TypeMeta, spec)}
+	if manifests.Service != nil {
+		selectors = append(selectors, selectorFor(manifests.Service.TypeMeta, spec))
+	}
+	if manifests.Ingress != nil {
+		selectors = append(selectors, selectorFor(manifests.Ingress.TypeMeta, spec))
+	}
+	clusterNames := make([]string, 0, len(spec.Clusters))
+	for _, cluster := range spec.Clusters {
+		clusterNames = append(clusterNames, cluster.Name)
+	}
+	pp := &policyv1alpha1.PropagationPolicy{
+		TypeMeta:   metav1.TypeMeta{APIVersion: policyv1alpha1.SchemeGroupVersion.String(), Kind: policyv1alpha1.ResourceKindPropagationPolicy},
+		ObjectMeta: metav1.ObjectMeta{Namespace: spec.Namespace, Name: spec.Name, Labels: labels},
+		Spec: policyv1alpha1.PropagationSpec{
+			ResourceSelectors: selectors,
+			PropagateDeps:     true,
+			Placement: policyv1alpha1.Placement{
+				ClusterAffinity: &policyv1alpha1.ClusterAffinity{ClusterNames: clusterNames},
+				ReplicaScheduling: &policyv1alpha1.ReplicaSchedulingStrategy{
+					ReplicaSchedulingType: policyv1alpha1.ReplicaSchedulingTypeDuplicated,
+				},
+			},
+		},
+	}
+	propagationpolicy.SetDefaultPropagationSpec(spec.Namespace, &pp.Spec)
+	return pp
+}
+
+// newOverridePolicy returns the policy overriding the replicas and image of the app in a cluster, nil if
+// the cluster runs the app as is.
+func newOverridePolicy(spec Spec, labels map[string]string, registry *config.DockerRegistry, cluster Cluster) *policyv1alpha1.OverridePolicy {
+	var overriders []policyv1alpha1.PlaintextOverrider
+	if cluster.Replicas != nil && *cluster.Replicas != *spec.Replicas {
+		overriders = append(overriders, replaceOverrider("/spec/replicas", *cluster.Replicas))
+	}
+	if cluster.Image != "" && cluster.Image != spec.Image {
+		overriders = append(overriders, replaceOverrider("/spec/template/spec/containers/0/image", image(registry, cluster.Image)))
+	}
+	if len(overriders) == 0 {
+		return nil
+	}
+	op := &policyv1alpha1.OverridePolicy{
+		TypeMeta:   metav1.TypeMeta{APIVersion: policyv1alpha1.SchemeGroupVersion.String(), Kind: policyv1alpha1.ResourceKindOverridePolicy},
+		ObjectMeta: metav1.ObjectMeta{Namespace: spec.Namespace, Name: fmt.Sprintf("%s-%s", spec.Name, cluster.Name), Labels: labels},
+		Spec: policyv1alpha1.OverrideSpec{
+			ResourceSelectors: []policyv1alpha1.ResourceSelector{
+				selectorFor(metav1.TypeMeta{APIVersion: appsv1.SchemeGroupVersion.String(), Kind: "Deployment"}, spec),
+			},
+			OverrideRules: []policyv1alpha1.RuleWithCluster{{
+				TargetCluster: &policyv1alpha1.ClusterAffinity{ClusterNames: []string{cluster.Name}},
+				Overriders:    policyv1alpha1.Overriders{Plaintext: overriders},
+			}},
+		},
+	}
+	overridepolicy.SetDefaultOverrideSpec(spec.Namespace, &op.Spec)
+	return op
+}
+
+func selectorFor(typeMeta metav1.TypeMeta, spec Spec) policyv1alpha1.ResourceSelector {
+	return policyv1alpha1.ResourceSelector{APIVersion: typeMeta.APIVersion, Kind: typeMeta.Kind, Namespace: spec.Namespace, Name: spec.Name}
+}
+
+func replaceOverrider(path string, value interface{}) policyv1alpha1.PlaintextOverrider {
+	// marshaling an int32 or a string never fails
+	raw, _ := json.Marshal(value)
+	return policyv1alpha1.PlaintextOverrider{
+		Path:     path,
+		Operator: policyv1alpha1.OverriderOpReplace,
+		Value:    apiextensionsv1.JSON{Raw: raw},
+	}
+}
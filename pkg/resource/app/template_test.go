@@ -0,0 +1,174 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/util/gclient"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/config"
+)
+
+var registries = []config.DockerRegistry{{Name: "hub", URL: "https://registry.example.com/"}}
+
+func testSpec() Spec {
+	return Spec{
+		Name:     "nginx",
+		Registry: "hub",
+		Image:    "nginx:1.25",
+		Replicas: ptr.To[int32](2),
+		Ports:    []Port{{Name: "http", ContainerPort: 80}},
+		Env:      []EnvVar{{Name: "PASSWORD", ValueFrom: &EnvRef{Kind: types.ResourceKindSecret, Name: "nginx", Key: "password"}}},
+		EnvFrom:  []EnvSource{{Kind: types.ResourceKindConfigMap, Name: "nginx"}},
+		Resources: Resources{
+			CPURequest:  "100m",
+			MemoryLimit: "128Mi",
+		},
+		ReadinessProbe: &Probe{Type: ProbeTypeHTTP, Path: "/healthz", Port: 80},
+		Ingress:        &Ingress{Host: "nginx.example.com"},
+		Clusters: []Cluster{
+			{Name: "member1"},
+			{Name: "member2", Replicas: ptr.To[int32](5), Image: "nginx:1.26"},
+		},
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	manifests, err := Generate(testSpec(), registries)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	container := manifests.Deployment.Spec.Template.Spec.Containers[0]
+	if container.Image != "registry.example.com/nginx:1.25" {
+		t.Errorf("image = %s, want registry.example.com/nginx:1.25", container.Image)
+	}
+	if manifests.Deployment.Namespace != "default" || *manifests.Deployment.Spec.Replicas != 2 {
+		t.Errorf("deployment %s/%s has %d replicas", manifests.Deployment.Namespace, manifests.Deployment.Name, *manifests.Deployment.Spec.Replicas)
+	}
+	if container.Env[0].ValueFrom.SecretKeyRef == nil || container.EnvFrom[0].ConfigMapRef == nil {
+		t.Errorf("env = %v, envFrom = %v", container.Env, container.EnvFrom)
+	}
+	if got := container.Resources.Requests.Cpu().String(); got != "100m" {
+		t.Errorf("cpu request = %s, want 100m", got)
+	}
+	if manifests.Service == nil || manifests.Service.Spec.Ports[0].Port != 80 || manifests.Service.Spec.Type != corev1.ServiceTypeClusterIP {
+		t.Errorf("service = %v", manifests.Service)
+	}
+	if manifests.Ingress == nil || manifests.Ingress.Spec.Rules[0].HTTP.Paths[0].Backend.Service.Port.Number != 80 {
+		t.Errorf("ingress = %v", manifests.Ingress)
+	}
+
+	var selected []string
+	for _, rs := range manifests.PropagationPolicy.Spec.ResourceSelectors {
+		selected = append(selected, rs.Kind)
+	}
+	if want := []string{"Deployment", "Service", "Ingress"}; !reflect.DeepEqual(selected, want) {
+		t.Errorf("propagation policy selects %v, want %v", selected, want)
+	}
+	if !reflect.DeepEqual(manifests.PropagationPolicy.Spec.Placement.ClusterAffinity.ClusterNames, []string{"member1", "member2"}) {
+		t.Errorf("propagation policy placement = %v", manifests.PropagationPolicy.Spec.Placement)
+	}
+
+	if len(manifests.OverridePolicies) != 1 {
+		t.Fatalf("got %d override policies, want 1", len(manifests.OverridePolicies))
+	}
+	var overridden []string
+	for _, overrider := range manifests.OverridePolicies[0].Spec.OverrideRules[0].Overriders.Plaintext {
+		overridden = append(overridden, fmt.Sprintf("%s=%s", overrider.Path, overrider.Value.Raw))
+	}
+	want := []string{"/spec/replicas=5", `/spec/template/spec/containers/0/image="registry.example.com/nginx:1.26"`}
+	if !reflect.DeepEqual(overridden, want) {
+		t.Errorf("member2 overrides %v, want %v", overridden, want)
+	}
+	if got := strings.Count(manifests.YAML, "\n---\n") + 1; got != 5 {
+		t.Errorf("yaml has %d documents, want 5", got)
+	}
+}
+
+func TestGenerateInvalid(t *testing.T) {
+	cases := []struct {
+		name   string
+		mutate func(spec *Spec)
+		want   string
+	}{
+		{name: "unknown registry", mutate: func(spec *Spec) { spec.Registry = "unknown" }, want: "registry"},
+		{name: "invalid name", mutate: func(spec *Spec) { spec.Name = "Nginx" }, want: "name"},
+		{name: "invalid quantity", mutate: func(spec *Spec) { spec.Resources.CPULimit = "a lot" }, want: "resources.cpuLimit"},
+		{name: "env of a pod", mutate: func(spec *Spec) { spec.EnvFrom[0].Kind = types.ResourceKindPod }, want: "envFrom[0].kind"},
+		{name: "probe without port", mutate: func(spec *Spec) { spec.ReadinessProbe.Port = 0 }, want: "readinessProbe.port"},
+		{name: "ingress without port", mutate: func(spec *Spec) { spec.Ports = nil }, want: "ports"},
+		{name: "duplicated cluster", mutate: func(spec *Spec) { spec.Clusters[1].Name = "member1" }, want: "clusters[1].name"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := testSpec()
+			tc.mutate(&spec)
+			_, err := Generate(spec, registries)
+			if !k8serrors.IsInvalid(err) || !strings.Contains(err.Error(), tc.want) {
+				t.Errorf("Generate() error = %v, want invalid %s", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	manifests, err := Generate(testSpec(), registries)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	// the ingress is rejected after the dry run, e.g. by an admission webhook of the host
+	c := fake.NewClientBuilder().WithScheme(gclient.NewSchema()).WithInterceptorFuncs(interceptor.Funcs{
+		Create: func(ctx context.Context, c ctrlclient.WithWatch, obj ctrlclient.Object, opts ...ctrlclient.CreateOption) error {
+			createOpts := &ctrlclient.CreateOptions{}
+			createOpts.ApplyOptions(opts)
+			if _, ok := obj.(*networkingv1.Ingress); ok && len(createOpts.DryRun) == 0 {
+				return k8serrors.NewForbidden(networkingv1.Resource("ingresses"), obj.GetName(), fmt.Errorf("host is taken"))
+			}
+			return c.Create(ctx, obj, opts...)
+		},
+	}).Build()
+
+	if err = Apply(context.TODO(), c, manifests); !k8serrors.IsForbidden(err) {
+		t.Fatalf("Apply() error = %v, want forbidden", err)
+	}
+	for _, obj := range []ctrlclient.Object{&appsv1.Deployment{}, &corev1.Service{}, &policyv1alpha1.PropagationPolicy{}} {
+		err = c.Get(context.TODO(), ctrlclient.ObjectKey{Namespace: "default", Name: "nginx"}, obj)
+		if !k8serrors.IsNotFound(err) {
+			t.Errorf("%T is left behind, get error = %v", obj, err)
+		}
+	}
+	err = c.Get(context.TODO(), ctrlclient.ObjectKey{Namespace: "default", Name: "nginx-member2"}, &policyv1alpha1.OverridePolicy{})
+	if !k8serrors.IsNotFound(err) {
+		t.Errorf("override policy is left behind, get error = %v", err)
+	}
+}
@@ -0,0 +1,257 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ResourceCapacity is the capacity of a single resource of a cluster.
+type ResourceCapacity struct {
+	Name        corev1.ResourceName `json:"name"`
+	Allocatable resource.Quantity   `json:"allocatable"`
+	Allocated   resource.Quantity   `json:"allocated"`
+	Allocating  resource.Quantity   `json:"allocating"`
+	// Available is the allocatable resource not yet allocated or being allocated.
+	Available resource.Quantity `json:"available"`
+	// Fraction is the percentage of allocatable resource allocated or being allocated.
+	Fraction float64 `json:"fraction"`
+}
+
+// ModelingGrade is the number of nodes of a cluster in a grade of the resource models.
+type ModelingGrade struct {
+	Grade uint `json:"grade"`
+	// Count is the number of nodes whose allocatable resources fall in the grade.
+	Count  int                           `json:"count"`
+	Ranges []v1alpha1.ResourceModelRange `json:"ranges"`
+}
+
+// ClusterCapacity is the resource breakdown of a cluster.
+type ClusterCapacity struct {
+	Cluster string `json:"cluster"`
+	// Resources contains every resource reported by the cluster, including ephemeral storage, GPUs and
+	// extended resources, ordered by name.
+	Resources []ResourceCapacity `json:"resources"`
+	// Modelings is the histogram of nodes by grade of the customized resource models, empty if the cluster
+	// has no resource models.
+	Modelings []ModelingGrade `json:"modelings"`
+}
+
+// ReplicaEstimation is the estimated number of replicas of a pod that can be scheduled to a cluster.
+type ReplicaEstimation struct {
+	Cluster         string              `json:"cluster"`
+	ResourceRequest corev1.ResourceList `json:"resourceRequest"`
+	MaxReplicas     int64               `json:"maxReplicas"`
+	// LimitedBy is the resource limiting the replicas based on the resource summary.
+	LimitedBy corev1.ResourceName `json:"limitedBy,omitempty"`
+	// ModelingReplicas is the replicas estimated from the resource models, nil if the cluster has no
+	// resource models. It accounts for fragmentation across nodes, MaxReplicas is the smaller of both.
+	ModelingReplicas *int64 `json:"modelingReplicas,omitempty"`
+}
+
+// GetClusterCapacity gets the resource breakdown of a cluster.
+func GetClusterCapacity(client karmadaclientset.Interface, clusterName string) (*ClusterCapacity, error) {
+	cluster, err := client.ClusterV1alpha1().Clusters().Get(context.TODO(), clusterName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return toClusterCapacity(cluster), nil
+}
+
+// EstimateClusterReplicas estimates how many replicas of a pod with the given resource request the cluster
+// can take in.
+func EstimateClusterReplicas(client karmadaclientset.Interface, clusterName string, request corev1.ResourceList) (*ReplicaEstimation, error) {
+	cluster, err := client.ClusterV1alpha1().Clusters().Get(context.TODO(), clusterName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return estimateReplicas(cluster, request), nil
+}
+
+func toClusterCapacity(cluster *v1alpha1.Cluster) *ClusterCapacity {
+	result := &ClusterCapacity{
+		Cluster:   cluster.Name,
+		Resources: make([]ResourceCapacity, 0),
+		Modelings: make([]ModelingGrade, 0),
+	}
+	summary := cluster.Status.ResourceSummary
+	if summary == nil {
+		return result
+	}
+
+	available := AvailableResources(cluster)
+	for name, allocatable := range summary.Allocatable {
+		capacity := ResourceCapacity{
+			Name:        name,
+			Allocatable: allocatable,
+			Allocated:   summary.Allocated[name],
+			Allocating:  summary.Allocating[name],
+			Available:   available[name],
+		}
+		if allocatable.Sign() > 0 {
+			used := capacity.Allocated.AsApproximateFloat64() + capacity.Allocating.AsApproximateFloat64()
+			capacity.Fraction = used / allocatable.AsApproximateFloat64() * 100
+		}
+		result.Resources = append(result.Resources, capacity)
+	}
+	sort.Slice(result.Resources, func(i, j int) bool {
+		return result.Resources[i].Name < result.Resources[j].Name
+	})
+
+	models := make(map[uint][]v1alpha1.ResourceModelRange, len(cluster.Spec.ResourceModels))
+	for _, model := range cluster.Spec.ResourceModels {
+		models[model.Grade] = model.Ranges
+	}
+	for _, modeling := range summary.AllocatableModelings {
+		result.Modelings = append(result.Modelings, ModelingGrade{
+			Grade:  modeling.Grade,
+			Count:  modeling.Count,
+			Ranges: models[modeling.Grade],
+		})
+	}
+	sort.Slice(result.Modelings, func(i, j int) bool {
+		return result.Modelings[i].Grade < result.Modelings[j].Grade
+	})
+	return result
+}
+
+// AvailableResources returns the allocatable resources of the cluster not yet allocated or being allocated.
+func AvailableResources(cluster *v1alpha1.Cluster) corev1.ResourceList {
+	available := corev1.ResourceList{}
+	summary := cluster.Status.ResourceSummary
+	if summary == nil {
+		return available
+	}
+	for name, allocatable := range summary.Allocatable {
+		quantity := allocatable.DeepCopy()
+		if allocated, ok := summary.Allocated[name]; ok {
+			quantity.Sub(allocated)
+		}
+		if allocating, ok := summary.Allocating[name]; ok {
+			quantity.Sub(allocating)
+		}
+		if quantity.Sign() < 0 {
+			quantity = resource.Quantity{}
+		}
+		available[name] = quantity
+	}
+	return available
+}
+
+// estimateReplicas follows the general estimator of karmada-scheduler: the replicas are limited by the
+// available resources in the resource summary, including the number of pods, and by the resource models
+// if the cluster has any.
+func estimateReplicas(cluster *v1alpha1.Cluster, request corev1.ResourceList) *ReplicaEstimation {
+	result := &ReplicaEstimation{
+		Cluster:         cluster.Name,
+		ResourceRequest: request,
+	}
+	available := AvailableResources(cluster)
+	maxReplicas := int64(math.MaxInt64)
+	if pods, ok := available[corev1.ResourcePods]; ok {
+		maxReplicas = pods.Value()
+		result.LimitedBy = corev1.ResourcePods
+	}
+	names := make([]string, 0, len(request))
+	for name := range request {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		requested := request[corev1.ResourceName(name)]
+		if requested.IsZero() {
+			continue
+		}
+		replicas := int64(0)
+		if quantity, ok := available[corev1.ResourceName(name)]; ok {
+			replicas = quantity.MilliValue() / requested.MilliValue()
+		}
+		if replicas < maxReplicas {
+			maxReplicas = replicas
+			result.LimitedBy = corev1.ResourceName(name)
+		}
+	}
+	if maxReplicas == math.MaxInt64 {
+		maxReplicas = 0
+		result.LimitedBy = ""
+	}
+
+	if modelingReplicas, ok := estimateReplicasByModels(cluster, request); ok {
+		result.ModelingReplicas = &modelingReplicas
+		if modelingReplicas < maxReplicas {
+			maxReplicas = modelingReplicas
+		}
+	}
+	result.MaxReplicas = maxReplicas
+	return result
+}
+
+// estimateReplicasByModels counts the replicas that fit in every node by assuming each node only has the
+// minimum resources of its grade.
+func estimateReplicasByModels(cluster *v1alpha1.Cluster, request corev1.ResourceList) (int64, bool) {
+	summary := cluster.Status.ResourceSummary
+	if len(cluster.Spec.ResourceModels) == 0 || summary == nil || len(summary.AllocatableModelings) == 0 {
+		return 0, false
+	}
+	models := make(map[uint][]v1alpha1.ResourceModelRange, len(cluster.Spec.ResourceModels))
+	for _, model := range cluster.Spec.ResourceModels {
+		models[model.Grade] = model.Ranges
+	}
+
+	var total int64
+	constrained := false
+	for _, modeling := range summary.AllocatableModelings {
+		ranges, ok := models[modeling.Grade]
+		if !ok || modeling.Count == 0 {
+			continue
+		}
+		perNode := int64(math.MaxInt64)
+		for name, requested := range request {
+			if requested.IsZero() {
+				continue
+			}
+			var minimum *resource.Quantity
+			for i := range ranges {
+				if ranges[i].Name == name {
+					minimum = &ranges[i].Min
+					break
+				}
+			}
+			if minimum == nil {
+				// the models do not constrain this resource.
+				continue
+			}
+			if replicas := minimum.MilliValue() / requested.MilliValue(); replicas < perNode {
+				perNode = replicas
+			}
+		}
+		if perNode == math.MaxInt64 {
+			continue
+		}
+		constrained = true
+		total += perNode * int64(modeling.Count)
+	}
+	return total, constrained
+}
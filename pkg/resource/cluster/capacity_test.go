@@ -0,0 +1,131 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"math"
+	"testing"
+
+	"github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newCapacityCluster(models bool) *v1alpha1.Cluster {
+	cluster := &v1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "member1"},
+		Status: v1alpha1.ClusterStatus{
+			ResourceSummary: &v1alpha1.ResourceSummary{
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("10"),
+					corev1.ResourceMemory: resource.MustParse("20Gi"),
+					corev1.ResourcePods:   resource.MustParse("110"),
+					"nvidia.com/gpu":      resource.MustParse("4"),
+				},
+				Allocated: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("3"),
+					corev1.ResourceMemory: resource.MustParse("4Gi"),
+					corev1.ResourcePods:   resource.MustParse("10"),
+				},
+				Allocating: corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("1"),
+				},
+			},
+		},
+	}
+	if models {
+		cluster.Spec.ResourceModels = []v1alpha1.ResourceModel{
+			{Grade: 0, Ranges: []v1alpha1.ResourceModelRange{{Name: corev1.ResourceCPU, Min: resource.MustParse("0"), Max: resource.MustParse("2")}}},
+			{Grade: 1, Ranges: []v1alpha1.ResourceModelRange{{Name: corev1.ResourceCPU, Min: resource.MustParse("2"), Max: *resource.NewQuantity(math.MaxInt64, resource.DecimalSI)}}},
+		}
+		cluster.Status.ResourceSummary.AllocatableModelings = []v1alpha1.AllocatableModeling{{Grade: 0, Count: 3}, {Grade: 1, Count: 2}}
+	}
+	return cluster
+}
+
+func TestEstimateReplicas(t *testing.T) {
+	cases := []struct {
+		name          string
+		models        bool
+		request       corev1.ResourceList
+		wantReplicas  int64
+		wantLimitedBy corev1.ResourceName
+	}{
+		{
+			name:          "limited by cpu",
+			request:       corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m"), corev1.ResourceMemory: resource.MustParse("1Gi")},
+			wantReplicas:  12,
+			wantLimitedBy: corev1.ResourceCPU,
+		},
+		{
+			name:          "limited by gpu",
+			request:       corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m"), "nvidia.com/gpu": resource.MustParse("1")},
+			wantReplicas:  4,
+			wantLimitedBy: "nvidia.com/gpu",
+		},
+		{
+			name:          "resource not provided by cluster",
+			request:       corev1.ResourceList{"example.com/fpga": resource.MustParse("1")},
+			wantReplicas:  0,
+			wantLimitedBy: "example.com/fpga",
+		},
+		{
+			name:          "limited by pods",
+			request:       corev1.ResourceList{},
+			wantReplicas:  100,
+			wantLimitedBy: corev1.ResourcePods,
+		},
+		{
+			name:          "limited by resource models",
+			models:        true,
+			request:       corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+			wantReplicas:  4,
+			wantLimitedBy: corev1.ResourceCPU,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := estimateReplicas(newCapacityCluster(c.models), c.request)
+			if got.MaxReplicas != c.wantReplicas || got.LimitedBy != c.wantLimitedBy {
+				t.Errorf("estimateReplicas() = %d limited by %s, want %d limited by %s", got.MaxReplicas, got.LimitedBy, c.wantReplicas, c.wantLimitedBy)
+			}
+		})
+	}
+}
+
+func TestToClusterCapacity(t *testing.T) {
+	got := toClusterCapacity(newCapacityCluster(true))
+	if len(got.Resources) != 4 {
+		t.Fatalf("toClusterCapacity() resources = %v, want 4 resources", got.Resources)
+	}
+	cpu := got.Resources[0]
+	if cpu.Name != corev1.ResourceCPU || cpu.Available.Cmp(resource.MustParse("6")) != 0 || cpu.Fraction != 40 {
+		t.Errorf("toClusterCapacity() cpu = %+v, want 6 available and 40%% used", cpu)
+	}
+	if len(got.Modelings) != 2 || got.Modelings[1].Count != 2 || len(got.Modelings[1].Ranges) != 1 {
+		t.Errorf("toClusterCapacity() modelings = %+v", got.Modelings)
+	}
+
+	allocated, err := getclusterAllocatedResources(newCapacityCluster(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := resource.MustParse("20Gi"); allocated.MemoryCapacity != want.Value() {
+		t.Errorf("getclusterAllocatedResources() memory capacity = %d, want %d", allocated.MemoryCapacity, want.Value())
+	}
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+
+	clusterapis "github.com/karmada-io/karmada/pkg/apis/cluster"
+	"github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/apis/cluster/validation"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ValidateClusterSpec validates the spec of a cluster with the rules of karmada-webhook.
+func ValidateClusterSpec(spec *v1alpha1.ClusterSpec) field.ErrorList {
+	specPath := field.NewPath("spec")
+	internal := &clusterapis.ClusterSpec{}
+	if err := v1alpha1.Convert_v1alpha1_ClusterSpec_To_cluster_ClusterSpec(spec, internal, nil); err != nil {
+		return field.ErrorList{field.InternalError(specPath, err)}
+	}
+	return validation.ValidateClusterSpec(internal, specPath)
+}
+
+// ValidateClusterSecretRefs checks the secrets referenced by the cluster exist in the karmada control-plane.
+func ValidateClusterSecretRefs(client kubernetes.Interface, spec *v1alpha1.ClusterSpec) (field.ErrorList, error) {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+	refs := map[string]*v1alpha1.LocalSecretReference{
+		"secretRef":             spec.SecretRef,
+		"impersonatorSecretRef": spec.ImpersonatorSecretRef,
+	}
+	for _, name := range []string{"secretRef", "impersonatorSecretRef"} {
+		ref := refs[name]
+		if ref == nil || ref.Namespace == "" || ref.Name == "" {
+			continue
+		}
+		_, err := client.CoreV1().Secrets(ref.Namespace).Get(context.TODO(), ref.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			allErrs = append(allErrs, field.NotFound(specPath.Child(name), ref.Namespace+"/"+ref.Name))
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return allErrs, nil
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"math"
+	"testing"
+
+	"github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestValidateClusterSpec(t *testing.T) {
+	models := func(firstMin string) []v1alpha1.ResourceModel {
+		return []v1alpha1.ResourceModel{
+			{Grade: 0, Ranges: []v1alpha1.ResourceModelRange{{Name: corev1.ResourceCPU, Min: resource.MustParse(firstMin), Max: resource.MustParse("2")}}},
+			{Grade: 1, Ranges: []v1alpha1.ResourceModelRange{{Name: corev1.ResourceCPU, Min: resource.MustParse("2"), Max: *resource.NewQuantity(math.MaxInt64, resource.DecimalSI)}}},
+		}
+	}
+	cases := []struct {
+		name     string
+		spec     v1alpha1.ClusterSpec
+		wantErrs int
+	}{
+		{
+			name: "valid",
+			spec: v1alpha1.ClusterSpec{
+				SyncMode:       v1alpha1.Push,
+				Provider:       "aws",
+				Region:         "us-east-1",
+				Zones:          []string{"us-east-1a"},
+				ProxyURL:       "socks5://proxy:1080",
+				ResourceModels: models("0"),
+			},
+		},
+		{
+			name: "invalid proxy url and region",
+			spec: v1alpha1.ClusterSpec{
+				SyncMode: v1alpha1.Push,
+				Region:   "us east",
+				ProxyURL: "ftp://proxy",
+			},
+			wantErrs: 2,
+		},
+		{
+			name: "invalid resource models",
+			spec: v1alpha1.ClusterSpec{
+				SyncMode:       v1alpha1.Pull,
+				ResourceModels: models("1"),
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "secret ref without namespace",
+			spec: v1alpha1.ClusterSpec{
+				SyncMode:  v1alpha1.Push,
+				SecretRef: &v1alpha1.LocalSecretReference{Name: "member1"},
+			},
+			wantErrs: 1,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			errs := ValidateClusterSpec(&c.spec)
+			if len(errs) != c.wantErrs {
+				t.Errorf("ValidateClusterSpec() = %v, want %d errors", errs, c.wantErrs)
+			}
+		})
+	}
+}
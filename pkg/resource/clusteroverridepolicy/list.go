@@ -46,6 +46,9 @@ type ClusterOverridePolicy struct {
 	// Override specificed data
 	ResourceSelectors []v1alpha1.ResourceSelector `json:"resourceSelectors"`
 	OverrideRules     []v1alpha1.RuleWithCluster  `json:"overrideRules"`
+	// Warnings are the problems of the policy reported by policy analyzer, only set when the list is
+	// requested with analyze=true.
+	Warnings []string `json:"warnings"`
 }
 
 // GetClusterOverridePolicyList returns a list of all overiders in the karmada control-plance.
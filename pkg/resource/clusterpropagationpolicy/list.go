@@ -46,6 +46,9 @@ type ClusterPropagationPolicy struct {
 	SchedulerName     string                      `json:"schedulerName"`
 	ClusterAffinity   *v1alpha1.ClusterAffinity   `json:"clusterAffinity"`
 	ResourceSelectors []v1alpha1.ResourceSelector `json:"resourceSelectors"`
+	// Warnings are the problems of the policy reported by policy analyzer, only set when the list is
+	// requested with analyze=true.
+	Warnings []string `json:"warnings"`
 }
 
 // GetClusterPropagationPolicyList returns a list of all propagations in the karmada control-plance.
@@ -0,0 +1,41 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/util/names"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GetWorkloadDistribution returns the clusters and replicas a namespaced workload is scheduled to,
+// it's read from the ResourceBinding of the workload. An empty list is returned if the workload
+// has not been propagated yet.
+func GetWorkloadDistribution(client karmadaclientset.Interface, namespace, kind, name string) ([]workv1alpha2.TargetCluster, error) {
+	binding, err := client.WorkV1alpha2().ResourceBindings(namespace).Get(context.TODO(), names.GenerateBindingName(kind, name), metaV1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return []workv1alpha2.TargetCluster{}, nil
+		}
+		return nil, err
+	}
+	return binding.Spec.Clusters, nil
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cronfederatedhpa
+
+import (
+	"context"
+
+	autoscalingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/autoscaling/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+)
+
+// CronFederatedHPADetail is a presentation layer view of Karmada CronFederatedHPA resource.
+type CronFederatedHPADetail struct {
+	// Extends list item structure.
+	CronFederatedHPA `json:",inline"`
+
+	// ExecutionHistories is the execution history of every rule.
+	ExecutionHistories []autoscalingv1alpha1.ExecutionHistory `json:"executionHistories"`
+	// Distribution is the replicas of the scaled workload in every member cluster.
+	Distribution []workv1alpha2.TargetCluster `json:"distribution"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetCronFederatedHPADetail gets CronFederatedHPA details.
+func GetCronFederatedHPADetail(client karmadaclientset.Interface, namespace, name string) (*CronFederatedHPADetail, error) {
+	hpa, err := client.AutoscalingV1alpha1().CronFederatedHPAs(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	// a CronFederatedHPA scales either a workload directly or the FederatedHPA of the workload.
+	target := hpa.Spec.ScaleTargetRef
+	distribution := []workv1alpha2.TargetCluster{}
+	if target.Kind == autoscalingv1alpha1.FederatedHPAKind {
+		var fhpa *autoscalingv1alpha1.FederatedHPA
+		fhpa, err = client.AutoscalingV1alpha1().FederatedHPAs(namespace).Get(context.TODO(), target.Name, metaV1.GetOptions{})
+		if err == nil {
+			target = fhpa.Spec.ScaleTargetRef
+		} else if k8serrors.IsNotFound(err) {
+			target.Name, err = "", nil
+		}
+	}
+	if err == nil && target.Name != "" {
+		distribution, err = common.GetWorkloadDistribution(client, namespace, target.Kind, target.Name)
+	}
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	return toCronFederatedHPADetail(hpa, distribution, nonCriticalErrors), nil
+}
+
+func toCronFederatedHPADetail(hpa *autoscalingv1alpha1.CronFederatedHPA, distribution []workv1alpha2.TargetCluster, nonCriticalErrors []error) *CronFederatedHPADetail {
+	return &CronFederatedHPADetail{
+		CronFederatedHPA:   toCronFederatedHPA(hpa),
+		ExecutionHistories: hpa.Status.ExecutionHistories,
+		Distribution:       distribution,
+		Errors:             nonCriticalErrors,
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cronfederatedhpa
+
+import (
+	"context"
+
+	autoscalingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/autoscaling/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+)
+
+// CronFederatedHPAList contains a list of CronFederatedHPAs in the karmada control-plane.
+type CronFederatedHPAList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+
+	// Unordered list of CronFederatedHPAs.
+	Items []CronFederatedHPA `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// CronFederatedHPA contains information about a single CronFederatedHPA.
+type CronFederatedHPA struct {
+	ObjectMeta     types.ObjectMeta                           `json:"objectMeta"`
+	TypeMeta       types.TypeMeta                             `json:"typeMeta"`
+	ScaleTargetRef autoscalingv2.CrossVersionObjectReference  `json:"scaleTargetRef"`
+	Rules          []autoscalingv1alpha1.CronFederatedHPARule `json:"rules"`
+}
+
+// GetCronFederatedHPAList returns a list of all CronFederatedHPAs in the karmada control-plane.
+func GetCronFederatedHPAList(client karmadaclientset.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*CronFederatedHPAList, error) {
+	hpas, err := client.AutoscalingV1alpha1().CronFederatedHPAs(nsQuery.ToRequestParam()).List(context.TODO(), helpers.ListEverything)
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	return toCronFederatedHPAList(hpas.Items, nonCriticalErrors, dsQuery), nil
+}
+
+func toCronFederatedHPAList(hpas []autoscalingv1alpha1.CronFederatedHPA, nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery) *CronFederatedHPAList {
+	result := &CronFederatedHPAList{
+		Items:    make([]CronFederatedHPA, 0),
+		ListMeta: types.ListMeta{TotalItems: len(hpas)},
+		Errors:   nonCriticalErrors,
+	}
+
+	hpaCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(hpas), dsQuery)
+	hpas = fromCells(hpaCells)
+	result.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+
+	for i := range hpas {
+		result.Items = append(result.Items, toCronFederatedHPA(&hpas[i]))
+	}
+	return result
+}
+
+func toCronFederatedHPA(hpa *autoscalingv1alpha1.CronFederatedHPA) CronFederatedHPA {
+	return CronFederatedHPA{
+		ObjectMeta:     types.NewObjectMeta(hpa.ObjectMeta),
+		TypeMeta:       types.NewTypeMeta(types.ResourceKindCronFederatedHPA),
+		ScaleTargetRef: hpa.Spec.ScaleTargetRef,
+		Rules:          hpa.Spec.Rules,
+	}
+}
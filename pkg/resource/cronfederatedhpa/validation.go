@@ -0,0 +1,118 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cronfederatedhpa
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	autoscalingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/autoscaling/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/util/lifted"
+	"github.com/robfig/cron/v3"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/karmada-io/dashboard/pkg/common/types"
+)
+
+// ValidateCronFederatedHPA validates the name, namespace and spec of CronFederatedHPA like the webhook of
+// karmada does. A rule scaling a FederatedHPA sets its min/max replicas, a rule scaling a workload sets
+// its replicas.
+func ValidateCronFederatedHPA(namespace, name string, spec *autoscalingv1alpha1.CronFederatedHPASpec) field.ErrorList {
+	var allErrs field.ErrorList
+	metaPath := field.NewPath("metadata")
+	if name == "" {
+		allErrs = append(allErrs, field.Required(metaPath.Child("name"), ""))
+	} else {
+		for _, msg := range apimachineryvalidation.NameIsDNSSubdomain(name, false) {
+			allErrs = append(allErrs, field.Invalid(metaPath.Child("name"), name, msg))
+		}
+	}
+	for _, msg := range apimachineryvalidation.ValidateNamespaceName(namespace, false) {
+		allErrs = append(allErrs, field.Invalid(metaPath.Child("namespace"), namespace, msg))
+	}
+
+	specPath := field.NewPath("spec")
+	target := spec.ScaleTargetRef
+	allErrs = append(allErrs, lifted.ValidateCrossVersionObjectReference(target, specPath.Child("scaleTargetRef"))...)
+	scaleFHPA := target.APIVersion == autoscalingv1alpha1.GroupVersion.String()
+	if scaleFHPA && target.Kind != autoscalingv1alpha1.FederatedHPAKind {
+		allErrs = append(allErrs, field.NotSupported(specPath.Child("scaleTargetRef").Child("kind"), target.Kind,
+			[]string{autoscalingv1alpha1.FederatedHPAKind}))
+	}
+	if types.ResourceKind(strings.ToLower(target.Kind)) == types.ResourceKindHorizontalPodAutoscaler {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("scaleTargetRef").Child("kind"), target.Kind,
+			"HorizontalPodAutoscaler is not supported, scale a FederatedHPA instead"))
+	}
+
+	rulesPath := specPath.Child("rules")
+	if len(spec.Rules) == 0 {
+		allErrs = append(allErrs, field.Required(rulesPath, "at least one rule is required"))
+	}
+	ruleNames := make(map[string]bool)
+	for i, rule := range spec.Rules {
+		allErrs = append(allErrs, validateRule(rule, scaleFHPA, target.Kind, rulesPath.Index(i))...)
+		if ruleNames[rule.Name] {
+			allErrs = append(allErrs, field.Duplicate(rulesPath.Index(i).Child("name"), rule.Name))
+		}
+		ruleNames[rule.Name] = true
+	}
+	return allErrs
+}
+
+func validateRule(rule autoscalingv1alpha1.CronFederatedHPARule, scaleFHPA bool, targetKind string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if rule.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), ""))
+	}
+	if _, err := cron.ParseStandard(rule.Schedule); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("schedule"), rule.Schedule, err.Error()))
+	}
+	if rule.TimeZone != nil {
+		if _, err := time.LoadLocation(*rule.TimeZone); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeZone"), *rule.TimeZone, err.Error()))
+		}
+	}
+
+	if !scaleFHPA {
+		if rule.TargetReplicas == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("targetReplicas"),
+				fmt.Sprintf("targetReplicas is required to scale %s", targetKind)))
+		} else if *rule.TargetReplicas < 0 {
+			// scaling to 0 is allowed, e.g. to remove all the replicas at weekends
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("targetReplicas"), *rule.TargetReplicas,
+				"must be greater than or equal to 0"))
+		}
+		return allErrs
+	}
+	if rule.TargetMinReplicas == nil && rule.TargetMaxReplicas == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("targetMaxReplicas"),
+			"targetMinReplicas or targetMaxReplicas is required to scale FederatedHPA"))
+	}
+	if rule.TargetMinReplicas != nil && *rule.TargetMinReplicas <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("targetMinReplicas"), *rule.TargetMinReplicas, "must be greater than 0"))
+	}
+	if rule.TargetMaxReplicas != nil && *rule.TargetMaxReplicas <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("targetMaxReplicas"), *rule.TargetMaxReplicas, "must be greater than 0"))
+	}
+	if rule.TargetMinReplicas != nil && rule.TargetMaxReplicas != nil && *rule.TargetMinReplicas > *rule.TargetMaxReplicas {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("targetMinReplicas"), *rule.TargetMinReplicas,
+			"must be less than or equal to targetMaxReplicas"))
+	}
+	return allErrs
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cronfederatedhpa
+
+import (
+	"reflect"
+	"testing"
+
+	autoscalingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/autoscaling/v1alpha1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	"k8s.io/utils/ptr"
+)
+
+func TestValidateCronFederatedHPA(t *testing.T) {
+	deployment := autoscalingv2.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "nginx"}
+	fhpa := autoscalingv2.CrossVersionObjectReference{APIVersion: "autoscaling.karmada.io/v1alpha1", Kind: "FederatedHPA", Name: "nginx"}
+	rule := func(schedule string, replicas *int32) autoscalingv1alpha1.CronFederatedHPARule {
+		return autoscalingv1alpha1.CronFederatedHPARule{Name: "scale-up", Schedule: schedule, TargetReplicas: replicas}
+	}
+	cases := []struct {
+		name     string
+		hpa      string
+		spec     autoscalingv1alpha1.CronFederatedHPASpec
+		expected []string
+	}{
+		{
+			name: "valid", hpa: "nginx",
+			spec: autoscalingv1alpha1.CronFederatedHPASpec{ScaleTargetRef: deployment, Rules: []autoscalingv1alpha1.CronFederatedHPARule{
+				rule("0 8 * * 1-5", ptr.To[int32](0)),
+			}},
+		},
+		{
+			name: "valid scaling FederatedHPA", hpa: "nginx",
+			spec: autoscalingv1alpha1.CronFederatedHPASpec{ScaleTargetRef: fhpa, Rules: []autoscalingv1alpha1.CronFederatedHPARule{
+				{Name: "scale-up", Schedule: "@daily", TargetMinReplicas: ptr.To[int32](2), TimeZone: ptr.To("Asia/Shanghai")},
+			}},
+		},
+		{
+			name:     "missing name and rules",
+			spec:     autoscalingv1alpha1.CronFederatedHPASpec{ScaleTargetRef: deployment},
+			expected: []string{"metadata.name", "spec.rules"},
+		},
+		{
+			name: "invalid schedule and time zone", hpa: "nginx",
+			spec: autoscalingv1alpha1.CronFederatedHPASpec{ScaleTargetRef: deployment, Rules: []autoscalingv1alpha1.CronFederatedHPARule{
+				{Name: "scale-up", Schedule: "0 25 * * *", TargetReplicas: ptr.To[int32](1), TimeZone: ptr.To("Mars/Olympus")},
+			}},
+			expected: []string{"spec.rules[0].schedule", "spec.rules[0].timeZone"},
+		},
+		{
+			name: "replicas of workload", hpa: "nginx",
+			spec: autoscalingv1alpha1.CronFederatedHPASpec{ScaleTargetRef: deployment, Rules: []autoscalingv1alpha1.CronFederatedHPARule{
+				rule("@hourly", nil), rule("@daily", ptr.To[int32](-1)),
+			}},
+			expected: []string{"spec.rules[0].targetReplicas", "spec.rules[1].targetReplicas", "spec.rules[1].name"},
+		},
+		{
+			name: "replicas of FederatedHPA", hpa: "nginx",
+			spec: autoscalingv1alpha1.CronFederatedHPASpec{ScaleTargetRef: fhpa, Rules: []autoscalingv1alpha1.CronFederatedHPARule{
+				{Name: "none", Schedule: "@daily"},
+				{Name: "inverted", Schedule: "@daily", TargetMinReplicas: ptr.To[int32](3), TargetMaxReplicas: ptr.To[int32](2)},
+			}},
+			expected: []string{"spec.rules[0].targetMaxReplicas", "spec.rules[1].targetMinReplicas"},
+		},
+		{
+			name: "unsupported scale target", hpa: "nginx",
+			spec: autoscalingv1alpha1.CronFederatedHPASpec{
+				ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{APIVersion: "autoscaling/v2", Kind: "HorizontalPodAutoscaler", Name: "nginx"},
+				Rules:          []autoscalingv1alpha1.CronFederatedHPARule{rule("@daily", ptr.To[int32](1))},
+			},
+			expected: []string{"spec.scaleTargetRef.kind"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual []string
+			for _, err := range ValidateCronFederatedHPA("default", tc.hpa, &tc.spec) {
+				actual = append(actual, err.Field)
+			}
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("ValidateCronFederatedHPA() = %v, expected %v", actual, tc.expected)
+			}
+		})
+	}
+}
@@ -115,16 +115,11 @@ func TriggerCronJob(client client.Interface,
 
 	jobToCreate := &batch.Job{
 		ObjectMeta: meta.ObjectMeta{
-			Name:        newJobName,
-			Namespace:   namespace,
-			Annotations: annotations,
-			Labels:      labels,
-			OwnerReferences: []meta.OwnerReference{{
-				APIVersion: CronJobAPIVersion,
-				Kind:       CronJobKindName,
-				Name:       cronJob.Name,
-				UID:        cronJob.UID,
-			}},
+			Name:            newJobName,
+			Namespace:       namespace,
+			Annotations:     annotations,
+			Labels:          labels,
+			OwnerReferences: []meta.OwnerReference{*meta.NewControllerRef(cronJob, batch.SchemeGroupVersion.WithKind("CronJob"))},
 		},
 		Spec: cronJob.Spec.JobTemplate.Spec,
 	}
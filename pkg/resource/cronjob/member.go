@@ -0,0 +1,87 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cronjob
+
+import (
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+	"github.com/karmada-io/dashboard/pkg/resource/job"
+)
+
+// ClusterJobList is the jobs of a propagated cron job in a member cluster.
+type ClusterJobList struct {
+	Cluster string       `json:"cluster"`
+	Jobs    *job.JobList `json:"jobs"`
+	// Error is set when the jobs could not be retrieved from the member cluster.
+	Error string `json:"error,omitempty"`
+}
+
+// ClusterTriggerResult is the outcome of triggering a propagated cron job in a member cluster.
+type ClusterTriggerResult struct {
+	Cluster string `json:"cluster"`
+	Error   string `json:"error,omitempty"`
+}
+
+// GetPropagatedCronJobJobs returns the jobs of a cron job of the karmada control plane in every member cluster
+// it is scheduled to. Jobs are created by the cronjob controllers of the member clusters, the control plane
+// has none of them.
+func GetPropagatedCronJobJobs(karmadaClient karmadaclientset.Interface, clientFor common.ClientForCluster,
+	dsQuery *dataselect.DataSelectQuery, namespace, name string, active bool) ([]ClusterJobList, error) {
+	clusters, err := common.GetWorkloadDistribution(karmadaClient, namespace, "CronJob", name)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]ClusterJobList, 0, len(clusters))
+	for _, cluster := range clusters {
+		jobs, err := GetCronJobJobs(clientFor(cluster.Name), dsQuery, namespace, name, active)
+		if errors.IsNotFound(err) {
+			// not applied to the member cluster yet
+			continue
+		}
+		item := ClusterJobList{Cluster: cluster.Name, Jobs: jobs}
+		if err != nil {
+			item.Error = err.Error()
+		}
+		result = append(result, item)
+	}
+	return result, nil
+}
+
+// TriggerPropagatedCronJob manually triggers a cron job of the karmada control plane in every member cluster
+// it is scheduled to, the same way it runs on schedule.
+func TriggerPropagatedCronJob(karmadaClient karmadaclientset.Interface, clientFor common.ClientForCluster,
+	namespace, name string) ([]ClusterTriggerResult, error) {
+	clusters, err := common.GetWorkloadDistribution(karmadaClient, namespace, "CronJob", name)
+	if err != nil {
+		return nil, err
+	}
+	if len(clusters) == 0 {
+		return nil, errors.NewBadRequest("cron job is not scheduled to any member cluster")
+	}
+	result := make([]ClusterTriggerResult, 0, len(clusters))
+	for _, cluster := range clusters {
+		item := ClusterTriggerResult{Cluster: cluster.Name}
+		if err = TriggerCronJob(clientFor(cluster.Name), namespace, name); err != nil {
+			item.Error = err.Error()
+		}
+		result = append(result, item)
+	}
+	return result, nil
+}
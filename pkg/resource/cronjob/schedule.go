@@ -0,0 +1,92 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cronjob
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	batch "k8s.io/api/batch/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	client "k8s.io/client-go/kubernetes"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+)
+
+// DefaultNextRuns is the number of next runs returned when none is requested.
+const DefaultNextRuns = 5
+
+// CronJobSchedule contains the next times a cron job is scheduled to run.
+type CronJobSchedule struct {
+	Schedule string  `json:"schedule"`
+	TimeZone *string `json:"timeZone"`
+	Suspend  bool    `json:"suspend"`
+	// NextRuns are computed even when the cron job is suspended, it won't run until it's resumed.
+	NextRuns []metaV1.Time `json:"nextRuns"`
+}
+
+// GetCronJobSchedule returns the next count times the cron job is scheduled to run.
+func GetCronJobSchedule(client client.Interface, namespace, name string, count int) (*CronJobSchedule, error) {
+	cronJob, err := client.BatchV1().CronJobs(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	nextRuns, err := GetNextRuns(cronJob, time.Now(), count)
+	if err != nil {
+		return nil, err
+	}
+	return &CronJobSchedule{
+		Schedule: cronJob.Spec.Schedule,
+		TimeZone: cronJob.Spec.TimeZone,
+		Suspend:  cronJob.Spec.Suspend != nil && *cronJob.Spec.Suspend,
+		NextRuns: nextRuns,
+	}, nil
+}
+
+// GetNextRuns computes the next count times after now the cron job is scheduled to run, in the time zone of
+// the cron job like the cronjob controller does, or in the time zone of the schedule if it has a CRON_TZ prefix.
+func GetNextRuns(cronJob *batch.CronJob, now time.Time, count int) ([]metaV1.Time, error) {
+	spec := cronJob.Spec.Schedule
+	if cronJob.Spec.TimeZone != nil {
+		spec = fmt.Sprintf("CRON_TZ=%s %s", *cronJob.Spec.TimeZone, spec)
+	}
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return nil, errors.NewBadRequest(fmt.Sprintf("invalid schedule %q: %v", spec, err))
+	}
+
+	nextRuns := make([]metaV1.Time, 0, count)
+	for next := now; len(nextRuns) < count; {
+		next = schedule.Next(next)
+		if next.IsZero() {
+			// the schedule never fires, e.g. on February 30th
+			break
+		}
+		nextRuns = append(nextRuns, metaV1.NewTime(next))
+	}
+	return nextRuns, nil
+}
+
+// SuspendCronJob suspends or resumes the scheduling of a cron job, the jobs already started keep running.
+func SuspendCronJob(client client.Interface, namespace, name string, suspend bool) error {
+	patch := []byte(fmt.Sprintf(`{"spec":{"suspend":%t}}`, suspend))
+	_, err := client.BatchV1().CronJobs(namespace).Patch(context.TODO(), name, k8stypes.MergePatchType, patch, metaV1.PatchOptions{})
+	return err
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cronjob
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	batch "k8s.io/api/batch/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestGetNextRuns(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 10, 30, 0, 0, time.UTC)
+	cases := []struct {
+		name     string
+		schedule string
+		timeZone *string
+		want     []time.Time
+		wantErr  bool
+	}{
+		{
+			name:     "hourly",
+			schedule: "0 * * * *",
+			want:     []time.Time{time.Date(2024, time.March, 1, 11, 0, 0, 0, time.UTC), time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:     "time zone of the cron job",
+			schedule: "0 12 * * *",
+			timeZone: ptr.To("Asia/Tokyo"),
+			want:     []time.Time{time.Date(2024, time.March, 2, 3, 0, 0, 0, time.UTC), time.Date(2024, time.March, 3, 3, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:     "never fires",
+			schedule: "0 0 30 2 *",
+			want:     []time.Time{},
+		},
+		{
+			name:     "invalid schedule",
+			schedule: "every minute",
+			wantErr:  true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cronJob := &batch.CronJob{Spec: batch.CronJobSpec{Schedule: tc.schedule, TimeZone: tc.timeZone}}
+			nextRuns, err := GetNextRuns(cronJob, now, 2)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("GetNextRuns() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			got := make([]time.Time, 0, len(nextRuns))
+			for _, next := range nextRuns {
+				got = append(got, next.UTC())
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("GetNextRuns() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	apps "k8s.io/api/apps/v1"
+	client "k8s.io/client-go/kubernetes"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+)
+
+// GetAggregatedDeploymentList returns the deployments of all the member clusters cached by karmada-search,
+// every deployment carries the cluster it comes from in the resource.karmada.io/cached-from-cluster
+// annotation. The pods, replica sets and events are not looked up, they are not necessarily cached and
+// same-named deployments of different clusters can't be told apart by them, so the pods are counted from
+// the status of the deployments.
+func GetAggregatedDeploymentList(client client.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*DeploymentList, error) {
+	channel := common.GetDeploymentListChannel(client, nsQuery, 1)
+	deployments := <-channel.List
+	err := <-channel.Error
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	deploymentList := &DeploymentList{
+		Deployments: make([]Deployment, 0),
+		Errors:      nonCriticalErrors,
+	}
+	for _, deployment := range deployments.Items {
+		if deployment.Status.ReadyReplicas < deployment.Status.Replicas {
+			deploymentList.Status.Pending++
+		} else {
+			deploymentList.Status.Running++
+		}
+	}
+	deploymentCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(deployments.Items), dsQuery)
+	deploymentList.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+	for _, deployment := range fromCells(deploymentCells) {
+		deploymentList.Deployments = append(deploymentList.Deployments, toAggregatedDeployment(&deployment))
+	}
+	return deploymentList, nil
+}
+
+func toAggregatedDeployment(deployment *apps.Deployment) Deployment {
+	podInfo := common.GetPodInfo(deployment.Status.Replicas, deployment.Spec.Replicas, nil)
+	podInfo.Running = deployment.Status.ReadyReplicas
+	podInfo.Pending = deployment.Status.Replicas - deployment.Status.ReadyReplicas
+	return Deployment{
+		ObjectMeta:          types.NewObjectMeta(deployment.ObjectMeta),
+		TypeMeta:            types.NewTypeMeta(types.ResourceKindDeployment),
+		Pods:                podInfo,
+		ContainerImages:     common.GetContainerImages(&deployment.Spec.Template.Spec),
+		InitContainerImages: common.GetInitContainerImages(&deployment.Spec.Template.Spec),
+	}
+}
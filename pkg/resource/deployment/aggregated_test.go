@@ -0,0 +1,56 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"testing"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	apps "k8s.io/api/apps/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+)
+
+func TestGetAggregatedDeploymentList(t *testing.T) {
+	cached := func(cluster, namespace string, replicas, ready int32) *apps.Deployment {
+		return &apps.Deployment{
+			ObjectMeta: metaV1.ObjectMeta{Name: "nginx", Namespace: namespace, UID: types.UID(cluster),
+				Annotations: map[string]string{clusterv1alpha1.CacheSourceAnnotationKey: cluster}},
+			Spec:   apps.DeploymentSpec{Replicas: &replicas, Template: testTemplate("v1")},
+			Status: apps.DeploymentStatus{Replicas: replicas, ReadyReplicas: ready},
+		}
+	}
+	client := fake.NewSimpleClientset(cached("member1", "default", 2, 2), cached("member2", "kube-system", 3, 1))
+	list, err := GetAggregatedDeploymentList(client, common.NewNamespaceQuery(nil), dataselect.NoDataSelect)
+	if err != nil {
+		t.Fatalf("GetAggregatedDeploymentList() error = %v", err)
+	}
+	if list.Status.Running != 1 || list.Status.Pending != 1 {
+		t.Errorf("GetAggregatedDeploymentList() status = %+v, want 1 running and 1 pending", list.Status)
+	}
+	for _, deployment := range list.Deployments {
+		cluster := deployment.ObjectMeta.Annotations[clusterv1alpha1.CacheSourceAnnotationKey]
+		want := map[string][2]int32{"member1": {2, 0}, "member2": {1, 2}}[cluster]
+		if got := [2]int32{deployment.Pods.Running, deployment.Pods.Pending}; got != want {
+			t.Errorf("deployment of %q has %v running and pending pods, want %v", cluster, got, want)
+		}
+	}
+}
@@ -0,0 +1,362 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/pmezard/go-difflib/difflib"
+	apps "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	client "k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+)
+
+const (
+	// RevisionAnnotation is the revision the deployment controller sets on a deployment and its replica sets.
+	RevisionAnnotation = "deployment.kubernetes.io/revision"
+	// ChangeCauseAnnotation records the cause of a change of the pod template.
+	ChangeCauseAnnotation = "kubernetes.io/change-cause"
+)
+
+// RevisionCluster is a replica set backing a revision in a member cluster.
+type RevisionCluster struct {
+	Cluster       string `json:"cluster"`
+	ReplicaSet    string `json:"replicaSet"`
+	Revision      int64  `json:"revision"`
+	Replicas      int32  `json:"replicas"`
+	ReadyReplicas int32  `json:"readyReplicas"`
+}
+
+// Revision is a pod template a deployment has run with. Member clusters number their revisions on their
+// own, so revisions are identified by the hash of the pod template and Revision is the highest number
+// any member cluster gives it.
+type Revision struct {
+	Hash              string             `json:"hash"`
+	Revision          int64              `json:"revision"`
+	ChangeCause       string             `json:"changeCause"`
+	CreationTimestamp metaV1.Time        `json:"creationTimestamp"`
+	Images            []string           `json:"images"`
+	Template          v1.PodTemplateSpec `json:"template"`
+	Clusters          []RevisionCluster  `json:"clusters"`
+}
+
+// ClusterRollout is the revision a member cluster is currently running.
+type ClusterRollout struct {
+	Cluster           string `json:"cluster"`
+	Hash              string `json:"hash"`
+	Revision          int64  `json:"revision"`
+	Replicas          int32  `json:"replicas"`
+	UpdatedReplicas   int32  `json:"updatedReplicas"`
+	ReadyReplicas     int32  `json:"readyReplicas"`
+	AvailableReplicas int32  `json:"availableReplicas"`
+	// Error is set when the state of the member cluster could not be retrieved.
+	Error string `json:"error,omitempty"`
+}
+
+// RevisionHistory is the rollout history of a propagated deployment.
+type RevisionHistory struct {
+	// Revisions sorted from the newest to the oldest.
+	Revisions []Revision       `json:"revisions"`
+	Clusters  []ClusterRollout `json:"clusters"`
+}
+
+// RevisionDiff is the difference between the pod templates of two revisions.
+type RevisionDiff struct {
+	From *Revision `json:"from"`
+	To   *Revision `json:"to"`
+	// Diff is a unified diff of the pod templates in YAML.
+	Diff string `json:"diff"`
+}
+
+// RollbackResult is the outcome of rolling a deployment back.
+type RollbackResult struct {
+	Hash     string `json:"hash"`
+	Revision int64  `json:"revision"`
+	// Skipped is true when the deployment already runs the pod template of the revision.
+	Skipped bool `json:"skipped"`
+}
+
+// GetRevisionHistory returns the revisions of a deployment, read from the replica sets in the member clusters
+// it is scheduled to, since the replica sets are not created on the karmada control plane.
+func GetRevisionHistory(karmadaClient karmadaclientset.Interface, clientFor common.ClientForCluster, namespace, name string) (*RevisionHistory, error) {
+	clusters, err := common.GetWorkloadDistribution(karmadaClient, namespace, "Deployment", name)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make(map[string]*Revision)
+	history := &RevisionHistory{Revisions: []Revision{}, Clusters: []ClusterRollout{}}
+	for _, cluster := range clusters {
+		rollout, replicaSets, err := getClusterRollout(clientFor(cluster.Name), namespace, name)
+		if errors.IsNotFound(err) {
+			// not applied to the member cluster yet
+			continue
+		}
+		if err != nil {
+			history.Clusters = append(history.Clusters, ClusterRollout{Cluster: cluster.Name, Error: err.Error()})
+			continue
+		}
+		rollout.Cluster = cluster.Name
+		history.Clusters = append(history.Clusters, *rollout)
+		mergeRevisions(revisions, cluster.Name, replicaSets)
+	}
+
+	for _, revision := range revisions {
+		history.Revisions = append(history.Revisions, *revision)
+	}
+	sort.SliceStable(history.Revisions, func(i, j int) bool {
+		if history.Revisions[i].Revision != history.Revisions[j].Revision {
+			return history.Revisions[i].Revision > history.Revisions[j].Revision
+		}
+		return history.Revisions[i].Hash < history.Revisions[j].Hash
+	})
+	return history, nil
+}
+
+// GetRevisionDiff returns the difference between the pod templates of two revisions of a deployment.
+func GetRevisionDiff(karmadaClient karmadaclientset.Interface, clientFor common.ClientForCluster, namespace, name, from, to string) (*RevisionDiff, error) {
+	history, err := GetRevisionHistory(karmadaClient, clientFor, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	fromRevision, err := history.find(from)
+	if err != nil {
+		return nil, err
+	}
+	toRevision, err := history.find(to)
+	if err != nil {
+		return nil, err
+	}
+	diff, err := diffTemplates(fromRevision, toRevision)
+	if err != nil {
+		return nil, err
+	}
+	return &RevisionDiff{From: fromRevision, To: toRevision, Diff: diff}, nil
+}
+
+// Rollback sets the pod template of the deployment on the karmada control plane to the one of a revision,
+// like kubectl rollout undo does. The change is propagated and rolled out in every member cluster. The pod
+// templates come from the member clusters and contain the changes of override policies, so deployments
+// matched by an override policy are not rolled back, the template of one cluster would be pushed to all.
+func Rollback(k8sClient client.Interface, karmadaClient karmadaclientset.Interface, clientFor common.ClientForCluster,
+	namespace, name, hash string) (*RollbackResult, error) {
+	deployment, err := k8sClient.AppsV1().Deployments(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	overrides, err := matchingOverridePolicies(karmadaClient, deployment)
+	if err != nil {
+		return nil, err
+	}
+	if len(overrides) != 0 {
+		return nil, errors.NewBadRequest(fmt.Sprintf("deployment %s/%s is overridden by %s, its revisions differ between clusters and cannot be rolled back",
+			namespace, name, strings.Join(overrides, ", ")))
+	}
+
+	history, err := GetRevisionHistory(karmadaClient, clientFor, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	revision, err := history.find(hash)
+	if err != nil {
+		return nil, err
+	}
+	result := &RollbackResult{Hash: revision.Hash, Revision: revision.Revision}
+	if common.EqualIgnoreHash(deployment.Spec.Template, revision.Template) {
+		result.Skipped = true
+		return result, nil
+	}
+
+	deployment.Spec.Template = *revision.Template.DeepCopy()
+	if revision.ChangeCause != "" {
+		if deployment.Annotations == nil {
+			deployment.Annotations = map[string]string{}
+		}
+		deployment.Annotations[ChangeCauseAnnotation] = revision.ChangeCause
+	} else {
+		delete(deployment.Annotations, ChangeCauseAnnotation)
+	}
+	// the update fails on a conflict if the deployment has changed since it was read
+	if _, err = k8sClient.AppsV1().Deployments(namespace).Update(context.TODO(), deployment, metaV1.UpdateOptions{}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// matchingOverridePolicies returns the override policies and cluster override policies whose resource selectors
+// match the deployment.
+func matchingOverridePolicies(karmadaClient karmadaclientset.Interface, deployment *apps.Deployment) ([]string, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(deployment)
+	if err != nil {
+		return nil, err
+	}
+	resource := &unstructured.Unstructured{Object: content}
+	resource.SetAPIVersion(apps.SchemeGroupVersion.String())
+	resource.SetKind("Deployment")
+	matches := func(selectors []policyv1alpha1.ResourceSelector) bool {
+		return len(selectors) == 0 || util.ResourceMatchSelectors(resource, selectors...)
+	}
+
+	var names []string
+	ops, err := karmadaClient.PolicyV1alpha1().OverridePolicies(deployment.Namespace).List(context.TODO(), metaV1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, op := range ops.Items {
+		if matches(op.Spec.ResourceSelectors) {
+			names = append(names, "OverridePolicy "+op.Name)
+		}
+	}
+	cops, err := karmadaClient.PolicyV1alpha1().ClusterOverridePolicies().List(context.TODO(), metaV1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, cop := range cops.Items {
+		if matches(cop.Spec.ResourceSelectors) {
+			names = append(names, "ClusterOverridePolicy "+cop.Name)
+		}
+	}
+	return names, nil
+}
+
+func (h *RevisionHistory) find(hash string) (*Revision, error) {
+	for i := range h.Revisions {
+		if h.Revisions[i].Hash == hash {
+			return &h.Revisions[i], nil
+		}
+	}
+	return nil, errors.NewNotFound(fmt.Sprintf("revision %s not found", hash))
+}
+
+// getClusterRollout returns the revision the deployment runs in a member cluster and the replica sets it owns.
+func getClusterRollout(memberClient client.Interface, namespace, name string) (*ClusterRollout, []apps.ReplicaSet, error) {
+	deployment, err := memberClient.AppsV1().Deployments(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	selector, err := metaV1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return nil, nil, err
+	}
+	list, err := memberClient.AppsV1().ReplicaSets(namespace).List(context.TODO(), metaV1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rollout := &ClusterRollout{
+		Revision:          parseRevision(deployment.Annotations),
+		Replicas:          deployment.Status.Replicas,
+		UpdatedReplicas:   deployment.Status.UpdatedReplicas,
+		ReadyReplicas:     deployment.Status.ReadyReplicas,
+		AvailableReplicas: deployment.Status.AvailableReplicas,
+	}
+	replicaSets := make([]apps.ReplicaSet, 0, len(list.Items))
+	for _, rs := range list.Items {
+		if !metaV1.IsControlledBy(&rs, deployment) {
+			continue
+		}
+		replicaSets = append(replicaSets, rs)
+		if common.EqualIgnoreHash(rs.Spec.Template, deployment.Spec.Template) {
+			rollout.Hash = rs.Labels[apps.DefaultDeploymentUniqueLabelKey]
+		}
+	}
+	return rollout, replicaSets, nil
+}
+
+// mergeRevisions adds the replica sets of a member cluster to the revisions, keyed by pod template hash.
+func mergeRevisions(revisions map[string]*Revision, cluster string, replicaSets []apps.ReplicaSet) {
+	for _, rs := range replicaSets {
+		hash := rs.Labels[apps.DefaultDeploymentUniqueLabelKey]
+		number := parseRevision(rs.Annotations)
+		revision, ok := revisions[hash]
+		if !ok {
+			revision = &Revision{
+				Hash:              hash,
+				CreationTimestamp: rs.CreationTimestamp,
+				Template:          templateWithoutHash(rs.Spec.Template),
+				Clusters:          []RevisionCluster{},
+			}
+			revision.Images = make([]string, 0, len(revision.Template.Spec.Containers))
+			for _, container := range revision.Template.Spec.Containers {
+				revision.Images = append(revision.Images, container.Image)
+			}
+			revisions[hash] = revision
+		}
+		if number >= revision.Revision {
+			revision.Revision = number
+			if cause := rs.Annotations[ChangeCauseAnnotation]; cause != "" {
+				revision.ChangeCause = cause
+			}
+		}
+		if rs.CreationTimestamp.Before(&revision.CreationTimestamp) {
+			revision.CreationTimestamp = rs.CreationTimestamp
+		}
+		revision.Clusters = append(revision.Clusters, RevisionCluster{
+			Cluster:       cluster,
+			ReplicaSet:    rs.Name,
+			Revision:      number,
+			Replicas:      rs.Status.Replicas,
+			ReadyReplicas: rs.Status.ReadyReplicas,
+		})
+	}
+}
+
+func templateWithoutHash(template v1.PodTemplateSpec) v1.PodTemplateSpec {
+	template = *template.DeepCopy()
+	delete(template.Labels, apps.DefaultDeploymentUniqueLabelKey)
+	return template
+}
+
+func parseRevision(annotations map[string]string) int64 {
+	revision, err := strconv.ParseInt(annotations[RevisionAnnotation], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return revision
+}
+
+func diffTemplates(from, to *Revision) (string, error) {
+	fromYAML, err := yaml.Marshal(from.Template)
+	if err != nil {
+		return "", err
+	}
+	toYAML, err := yaml.Marshal(to.Template)
+	if err != nil {
+		return "", err
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(fromYAML)),
+		B:        difflib.SplitLines(string(toYAML)),
+		FromFile: fmt.Sprintf("revision %d (%s)", from.Revision, from.Hash),
+		ToFile:   fmt.Sprintf("revision %d (%s)", to.Revision, to.Hash),
+		Context:  3,
+	})
+}
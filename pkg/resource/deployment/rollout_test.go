@@ -0,0 +1,175 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	apps "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func testTemplate(image string) v1.PodTemplateSpec {
+	return v1.PodTemplateSpec{
+		ObjectMeta: metaV1.ObjectMeta{Labels: map[string]string{"app": "nginx"}},
+		Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "nginx", Image: image}}},
+	}
+}
+
+func testMemberObjects(revision string, image string, replicaSets map[string]string) []runtime.Object {
+	deployment := &apps.Deployment{
+		ObjectMeta: metaV1.ObjectMeta{Name: "nginx", Namespace: "default", UID: types.UID("nginx"),
+			Annotations: map[string]string{RevisionAnnotation: revision}},
+		Spec: apps.DeploymentSpec{
+			Selector: &metaV1.LabelSelector{MatchLabels: map[string]string{"app": "nginx"}},
+			Template: testTemplate(image),
+		},
+	}
+	objects := []runtime.Object{deployment}
+	for hash, rsImage := range replicaSets {
+		template := testTemplate(rsImage)
+		template.Labels[apps.DefaultDeploymentUniqueLabelKey] = hash
+		rs := &apps.ReplicaSet{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:      "nginx-" + hash,
+				Namespace: "default",
+				Labels:    template.Labels,
+				Annotations: map[string]string{
+					RevisionAnnotation:    map[string]string{"v1": "1", "v2": "2", "v3": "3"}[rsImage],
+					ChangeCauseAnnotation: "set image " + rsImage,
+				},
+				OwnerReferences: []metaV1.OwnerReference{*metaV1.NewControllerRef(deployment, apps.SchemeGroupVersion.WithKind("Deployment"))},
+			},
+			Spec: apps.ReplicaSetSpec{Template: template},
+		}
+		objects = append(objects, rs)
+	}
+	return objects
+}
+
+func TestRevisionHistory(t *testing.T) {
+	karmadaClient := karmadafake.NewSimpleClientset(&workv1alpha2.ResourceBinding{
+		ObjectMeta: metaV1.ObjectMeta{Name: "nginx-deployment", Namespace: "default"},
+		Spec: workv1alpha2.ResourceBindingSpec{
+			Clusters: []workv1alpha2.TargetCluster{{Name: "member1"}, {Name: "member2"}, {Name: "member3"}},
+		},
+	})
+	clients := map[string]kubernetes.Interface{
+		"member1": fake.NewSimpleClientset(testMemberObjects("3", "v3", map[string]string{"a": "v1", "b": "v2", "c": "v3"})...),
+		"member2": fake.NewSimpleClientset(testMemberObjects("2", "v2", map[string]string{"a": "v1", "b": "v2"})...),
+		// not applied yet
+		"member3": fake.NewSimpleClientset(),
+	}
+	clientFor := func(cluster string) kubernetes.Interface { return clients[cluster] }
+
+	history, err := GetRevisionHistory(karmadaClient, clientFor, "default", "nginx")
+	if err != nil {
+		t.Fatalf("GetRevisionHistory() error = %v", err)
+	}
+	var hashes []string
+	for _, revision := range history.Revisions {
+		hashes = append(hashes, revision.Hash)
+	}
+	if want := []string{"c", "b", "a"}; !reflect.DeepEqual(hashes, want) {
+		t.Errorf("revisions = %v, want %v", hashes, want)
+	}
+	if got := len(history.Revisions[1].Clusters); got != 2 {
+		t.Errorf("revision b runs in %d clusters, want 2", got)
+	}
+	var running []string
+	for _, rollout := range history.Clusters {
+		running = append(running, rollout.Cluster+"="+rollout.Hash)
+	}
+	if want := []string{"member1=c", "member2=b"}; !reflect.DeepEqual(running, want) {
+		t.Errorf("clusters = %v, want %v", running, want)
+	}
+
+	diff, err := GetRevisionDiff(karmadaClient, clientFor, "default", "nginx", "a", "c")
+	if err != nil {
+		t.Fatalf("GetRevisionDiff() error = %v", err)
+	}
+	if !strings.Contains(diff.Diff, "-  - image: v1") || !strings.Contains(diff.Diff, "+  - image: v3") {
+		t.Errorf("GetRevisionDiff() = %q, want the image changed from v1 to v3", diff.Diff)
+	}
+
+	cases := []struct {
+		hash        string
+		wantSkipped bool
+		wantImage   string
+	}{
+		{hash: "c", wantSkipped: true, wantImage: "v3"},
+		{hash: "a", wantSkipped: false, wantImage: "v1"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.hash, func(t *testing.T) {
+			k8sClient := fake.NewSimpleClientset(&apps.Deployment{
+				ObjectMeta: metaV1.ObjectMeta{Name: "nginx", Namespace: "default"},
+				Spec:       apps.DeploymentSpec{Template: testTemplate("v3")},
+			})
+			result, err := Rollback(k8sClient, karmadaClient, clientFor, "default", "nginx", tc.hash)
+			if err != nil {
+				t.Fatalf("Rollback() error = %v", err)
+			}
+			if result.Skipped != tc.wantSkipped {
+				t.Errorf("Rollback() skipped = %v, want %v", result.Skipped, tc.wantSkipped)
+			}
+			deployment, _ := k8sClient.AppsV1().Deployments("default").Get(context.TODO(), "nginx", metaV1.GetOptions{})
+			if got := deployment.Spec.Template.Spec.Containers[0].Image; got != tc.wantImage {
+				t.Errorf("image = %s, want %s", got, tc.wantImage)
+			}
+			if _, ok := deployment.Spec.Template.Labels[apps.DefaultDeploymentUniqueLabelKey]; ok {
+				t.Errorf("pod template hash label is copied to the deployment")
+			}
+		})
+	}
+
+	if _, err = Rollback(fake.NewSimpleClientset(), karmadaClient, clientFor, "default", "nginx", "unknown"); err == nil {
+		t.Errorf("Rollback() to an unknown revision succeeded")
+	}
+
+	// the revisions of an overridden deployment carry the overrides of the member cluster they come from
+	if _, err = karmadaClient.PolicyV1alpha1().OverridePolicies("default").Create(context.TODO(), &policyv1alpha1.OverridePolicy{
+		ObjectMeta: metaV1.ObjectMeta{Name: "nginx-image", Namespace: "default"},
+		Spec: policyv1alpha1.OverrideSpec{
+			ResourceSelectors: []policyv1alpha1.ResourceSelector{{APIVersion: "apps/v1", Kind: "Deployment", Name: "nginx"}},
+		},
+	}, metaV1.CreateOptions{}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	k8sClient := fake.NewSimpleClientset(&apps.Deployment{
+		ObjectMeta: metaV1.ObjectMeta{Name: "nginx", Namespace: "default"},
+		Spec:       apps.DeploymentSpec{Template: testTemplate("v3")},
+	})
+	if _, err = Rollback(k8sClient, karmadaClient, clientFor, "default", "nginx", "a"); err == nil {
+		t.Errorf("Rollback() of a deployment matched by an override policy succeeded")
+	}
+	deployment, _ := k8sClient.AppsV1().Deployments("default").Get(context.TODO(), "nginx", metaV1.GetOptions{})
+	if got := deployment.Spec.Template.Spec.Containers[0].Image; got != "v3" {
+		t.Errorf("image = %s, want the overridden deployment unchanged", got)
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package failover
+
+import (
+	"context"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
+)
+
+// BindingReference identifies a ResourceBinding or ClusterResourceBinding.
+type BindingReference struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+}
+
+// binding is the common view of ResourceBinding and ClusterResourceBinding.
+type binding struct {
+	ref    BindingReference
+	spec   *workv1alpha2.ResourceBindingSpec
+	status *workv1alpha2.ResourceBindingStatus
+}
+
+func (b *binding) scheduledTo(clusterName string) *workv1alpha2.TargetCluster {
+	for i := range b.spec.Clusters {
+		if b.spec.Clusters[i].Name == clusterName {
+			return &b.spec.Clusters[i]
+		}
+	}
+	return nil
+}
+
+func listBindings(client karmadaclientset.Interface) ([]binding, []error, error) {
+	resourceBindings, err := client.WorkV1alpha2().ResourceBindings("").List(context.TODO(), helpers.ListEverything)
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, nil, criticalError
+	}
+	clusterResourceBindings, err := client.WorkV1alpha2().ClusterResourceBindings().List(context.TODO(), helpers.ListEverything)
+	nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
+	if criticalError != nil {
+		return nil, nil, criticalError
+	}
+
+	bindings := make([]binding, 0, len(resourceBindings.Items)+len(clusterResourceBindings.Items))
+	for i := range resourceBindings.Items {
+		rb := &resourceBindings.Items[i]
+		bindings = append(bindings, binding{
+			ref:    BindingReference{Kind: workv1alpha2.ResourceKindResourceBinding, Namespace: rb.Namespace, Name: rb.Name},
+			spec:   &rb.Spec,
+			status: &rb.Status,
+		})
+	}
+	for i := range clusterResourceBindings.Items {
+		crb := &clusterResourceBindings.Items[i]
+		bindings = append(bindings, binding{
+			ref:    BindingReference{Kind: workv1alpha2.ResourceKindClusterResourceBinding, Name: crb.Name},
+			spec:   &crb.Spec,
+			status: &crb.Status,
+		})
+	}
+	return bindings, nonCriticalErrors, nil
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package failover
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/events"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+)
+
+// TimelineEntryType is the type of an entry in the failover timeline.
+type TimelineEntryType string
+
+const (
+	// TimelineEntryTaint is an eviction taint added to the cluster.
+	TimelineEntryTaint TimelineEntryType = "Taint"
+	// TimelineEntryEviction is a workload being evicted from the cluster.
+	TimelineEntryEviction TimelineEntryType = "Eviction"
+	// TimelineEntryReschedule is a workload rescheduled after being evicted from the cluster.
+	TimelineEntryReschedule TimelineEntryType = "Reschedule"
+)
+
+// EvictionTask is a graceful eviction task of a workload from a cluster.
+type EvictionTask struct {
+	Workload                          workv1alpha2.ObjectReference `json:"workload"`
+	Binding                           BindingReference             `json:"binding"`
+	workv1alpha2.GracefulEvictionTask `json:",inline"`
+}
+
+// TimelineEntry is a single failover related occurrence of a cluster.
+type TimelineEntry struct {
+	Time     metav1.Time                   `json:"time"`
+	Type     TimelineEntryType             `json:"type"`
+	Reason   string                        `json:"reason"`
+	Message  string                        `json:"message"`
+	Workload *workv1alpha2.ObjectReference `json:"workload,omitempty"`
+}
+
+// ClusterFailoverDetail is the failover state of a cluster with its eviction tasks and timeline.
+type ClusterFailoverDetail struct {
+	ClusterFailoverSummary `json:",inline"`
+
+	EvictionTasks []EvictionTask `json:"evictionTasks"`
+	// Timeline is ordered by time ascending. Rescheduling can only be related to the cluster while the eviction
+	// task is recorded in the binding, and events are subject to the event TTL of the karmada-apiserver.
+	Timeline []TimelineEntry `json:"timeline"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetClusterFailoverDetail returns the failover state of a cluster.
+func GetClusterFailoverDetail(client karmadaclientset.Interface, kubeClient kubernetes.Interface, clusterName string) (*ClusterFailoverDetail, error) {
+	cluster, err := client.ClusterV1alpha1().Clusters().Get(context.TODO(), clusterName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	bindings, nonCriticalErrors, err := listBindings(client)
+	if err != nil {
+		return nil, err
+	}
+	clusterEvents, err := listEvents(kubeClient, "Cluster", clusterName)
+	nonCriticalErrors, criticalError := errors.AppendError(err, nonCriticalErrors)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+	bindingEvents, err := listEvents(kubeClient, workv1alpha2.ResourceKindResourceBinding, "")
+	nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+	clusterBindingEvents, err := listEvents(kubeClient, workv1alpha2.ResourceKindClusterResourceBinding, "")
+	nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	evictionTasks := evictionTasksFrom(clusterName, bindings)
+	allEvents := append(append(clusterEvents, bindingEvents...), clusterBindingEvents...)
+	return &ClusterFailoverDetail{
+		ClusterFailoverSummary: toClusterFailoverSummary(cluster, len(evictionTasks)),
+		EvictionTasks:          evictionTasks,
+		Timeline:               buildTimeline(cluster, bindings, allEvents),
+		Errors:                 nonCriticalErrors,
+	}, nil
+}
+
+func listEvents(kubeClient kubernetes.Interface, kind, name string) ([]corev1.Event, error) {
+	selector := fields.Set{"involvedObject.kind": kind}
+	if name != "" {
+		selector["involvedObject.name"] = name
+	}
+	eventList, err := kubeClient.CoreV1().Events(metav1.NamespaceAll).List(context.TODO(), metav1.ListOptions{
+		FieldSelector: selector.AsSelector().String(),
+	})
+	if err != nil {
+		return []corev1.Event{}, err
+	}
+	return eventList.Items, nil
+}
+
+func evictionTasksFrom(clusterName string, bindings []binding) []EvictionTask {
+	tasks := make([]EvictionTask, 0)
+	for i := range bindings {
+		for _, task := range bindings[i].spec.GracefulEvictionTasks {
+			if task.FromCluster == clusterName {
+				tasks = append(tasks, EvictionTask{
+					Workload:             bindings[i].spec.Resource,
+					Binding:              bindings[i].ref,
+					GracefulEvictionTask: task,
+				})
+			}
+		}
+	}
+	return tasks
+}
+
+// buildTimeline derives the failover timeline of a cluster from its taints, the eviction tasks from it and
+// the events of the cluster and bindings.
+func buildTimeline(cluster *clusterv1alpha1.Cluster, bindings []binding, eventList []corev1.Event) []TimelineEntry {
+	timeline := make([]TimelineEntry, 0)
+	for _, taint := range noExecuteTaints(cluster.Spec.Taints) {
+		if taint.TimeAdded == nil {
+			continue
+		}
+		timeline = append(timeline, TimelineEntry{
+			Time:    *taint.TimeAdded,
+			Type:    TimelineEntryTaint,
+			Reason:  "TaintAdded",
+			Message: fmt.Sprintf("taint %s added", taint.ToString()),
+		})
+	}
+
+	// evictedSince records when the earliest eviction task from the cluster of each binding was created,
+	// schedule events of the binding after that are treated as failover-triggered rescheduling.
+	evictedSince := make(map[BindingReference]metav1.Time)
+	bindingMap := make(map[BindingReference]*binding, len(bindings))
+	for i := range bindings {
+		b := &bindings[i]
+		bindingMap[b.ref] = b
+		for _, task := range b.spec.GracefulEvictionTasks {
+			if task.FromCluster != cluster.Name || task.CreationTimestamp == nil {
+				continue
+			}
+			if since, ok := evictedSince[b.ref]; !ok || task.CreationTimestamp.Before(&since) {
+				evictedSince[b.ref] = *task.CreationTimestamp
+			}
+			timeline = append(timeline, TimelineEntry{
+				Time:     *task.CreationTimestamp,
+				Type:     TimelineEntryEviction,
+				Reason:   task.Reason,
+				Message:  task.Message,
+				Workload: &b.spec.Resource,
+			})
+		}
+	}
+
+	for i := range eventList {
+		event := &eventList[i]
+		eventTime := eventTimestamp(event)
+		switch event.InvolvedObject.Kind {
+		case "Cluster":
+			if event.InvolvedObject.Name == cluster.Name &&
+				(event.Reason == events.EventReasonTaintClusterSucceed || event.Reason == events.EventReasonTaintClusterFailed) {
+				timeline = append(timeline, TimelineEntry{Time: eventTime, Type: TimelineEntryTaint, Reason: event.Reason, Message: event.Message})
+			}
+		case workv1alpha2.ResourceKindResourceBinding, workv1alpha2.ResourceKindClusterResourceBinding:
+			ref := BindingReference{Kind: event.InvolvedObject.Kind, Namespace: event.InvolvedObject.Namespace, Name: event.InvolvedObject.Name}
+			b, ok := bindingMap[ref]
+			if !ok {
+				continue
+			}
+			switch event.Reason {
+			case events.EventReasonEvictWorkloadFromClusterSucceed, events.EventReasonEvictWorkloadFromClusterFailed:
+				if strings.Contains(event.Message, fmt.Sprintf("cluster %s ", cluster.Name)) {
+					timeline = append(timeline, TimelineEntry{Time: eventTime, Type: TimelineEntryEviction, Reason: event.Reason, Message: event.Message, Workload: &b.spec.Resource})
+				}
+			case events.EventReasonScheduleBindingSucceed, events.EventReasonScheduleBindingFailed:
+				if since, evicted := evictedSince[ref]; evicted && !eventTime.Before(&since) {
+					timeline = append(timeline, TimelineEntry{Time: eventTime, Type: TimelineEntryReschedule, Reason: event.Reason, Message: event.Message, Workload: &b.spec.Resource})
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(timeline, func(i, j int) bool {
+		return timeline[i].Time.Before(&timeline[j].Time)
+	})
+	return timeline
+}
+
+func eventTimestamp(event *corev1.Event) metav1.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp
+	case !event.EventTime.IsZero():
+		return metav1.NewTime(event.EventTime.Time)
+	case !event.FirstTimestamp.IsZero():
+		return event.FirstTimestamp
+	default:
+		return event.CreationTimestamp
+	}
+}
@@ -0,0 +1,543 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package failover
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
+	"github.com/karmada-io/dashboard/pkg/resource/cluster"
+)
+
+const (
+	// CordonTaintKey is the key of the NoSchedule taint added when cordoning a cluster.
+	CordonTaintKey = "dashboard.karmada.io/cordoned"
+	// DrainTaintKey is the key of the NoExecute taint added when a cluster has been drained.
+	DrainTaintKey = "dashboard.karmada.io/drained"
+	// drainProducer is recorded as the producer of the graceful eviction tasks created by drain.
+	drainProducer = "karmada-dashboard"
+)
+
+// DrainPhase is the phase of draining a cluster.
+type DrainPhase string
+
+const (
+	// DrainRunning means workloads are being evicted from the cluster.
+	DrainRunning DrainPhase = "Running"
+	// DrainSucceeded means all workloads have been rescheduled and the cluster is tainted with NoExecute.
+	DrainSucceeded DrainPhase = "Succeeded"
+	// DrainFailed means the drain stopped on an error, the cluster stays cordoned.
+	DrainFailed DrainPhase = "Failed"
+	// DrainRolledBack means a workload could not be rescheduled and the taints of the cluster were removed.
+	DrainRolledBack DrainPhase = "RolledBack"
+	// DrainCancelled means the cluster was uncordoned while being drained.
+	DrainCancelled DrainPhase = "Cancelled"
+)
+
+// DrainOptions controls the pace of draining a cluster.
+type DrainOptions struct {
+	// BatchSize is the number of workloads evicted at the same time.
+	BatchSize int
+	// BatchInterval is the time to wait between two batches.
+	BatchInterval time.Duration
+	// BatchTimeout is how long to wait for the workloads of a batch to be rescheduled.
+	BatchTimeout time.Duration
+	// Force starts draining even if the pre-check reports the other clusters lack capacity.
+	Force bool
+}
+
+// ResourceShortage is a resource that the other clusters do not have enough of for the evicted workloads.
+type ResourceShortage struct {
+	Name      corev1.ResourceName `json:"name"`
+	Required  resource.Quantity   `json:"required"`
+	Available resource.Quantity   `json:"available"`
+}
+
+// DrainPrecheck lists the workloads that would be evicted by draining a cluster and whether the other
+// clusters can take them in.
+type DrainPrecheck struct {
+	Cluster string `json:"cluster"`
+	// Workloads are the workloads that would be evicted, TolerationSeconds is always empty as drain evicts
+	// every workload not tolerating the drain taint right away.
+	Workloads []AffectedWorkload `json:"workloads"`
+	// TargetClusters are the ready clusters without NoSchedule or NoExecute taints the workloads can move to.
+	TargetClusters []string `json:"targetClusters"`
+	// Sufficient tells whether the free resources of the target clusters cover the resource requests of the
+	// workloads. It is an estimation summing up all target clusters, the scheduler may still fail to place them.
+	Sufficient bool               `json:"sufficient"`
+	Shortages  []ResourceShortage `json:"shortages"`
+}
+
+// DrainProgress is the progress of draining a cluster.
+type DrainProgress struct {
+	Cluster string     `json:"cluster"`
+	Phase   DrainPhase `json:"phase"`
+	// Total is the number of workloads to evict.
+	Total int `json:"total"`
+	// Evicted is the number of workloads evicted from the cluster.
+	Evicted int `json:"evicted"`
+	// Rescheduled is the number of evicted workloads scheduled to other clusters.
+	Rescheduled int `json:"rescheduled"`
+	// Current are the workloads of the batch being evicted.
+	Current        []BindingReference `json:"current"`
+	Message        string             `json:"message,omitempty"`
+	StartTime      metav1.Time        `json:"startTime"`
+	CompletionTime *metav1.Time       `json:"completionTime,omitempty"`
+}
+
+// drains tracks the drains started by this process, the progress is lost when the process restarts.
+var drains = struct {
+	sync.Mutex
+	progress map[string]*DrainProgress
+	cancel   map[string]context.CancelFunc
+}{
+	progress: make(map[string]*DrainProgress),
+	cancel:   make(map[string]context.CancelFunc),
+}
+
+// Cordon adds the cordon NoSchedule taint to the cluster, so that no more workloads are scheduled to it.
+func Cordon(client karmadaclientset.Interface, clusterName string) error {
+	_, _, err := updateClusterTaints(client, clusterName, false, func(existing []corev1.Taint) []corev1.Taint {
+		for _, taint := range existing {
+			if taint.Key == CordonTaintKey && taint.Effect == corev1.TaintEffectNoSchedule {
+				return existing
+			}
+		}
+		now := metav1.Now()
+		return append(append([]corev1.Taint{}, existing...), corev1.Taint{Key: CordonTaintKey, Effect: corev1.TaintEffectNoSchedule, TimeAdded: &now})
+	})
+	return err
+}
+
+// Uncordon cancels the running drain of the cluster and removes the cordon and drain taints from it.
+// Workloads already evicted are not moved back.
+func Uncordon(client karmadaclientset.Interface, clusterName string) error {
+	drains.Lock()
+	if cancel, ok := drains.cancel[clusterName]; ok {
+		cancel()
+	}
+	drains.Unlock()
+	return removeDrainTaints(client, clusterName)
+}
+
+func removeDrainTaints(client karmadaclientset.Interface, clusterName string) error {
+	_, _, err := updateClusterTaints(client, clusterName, false, func(existing []corev1.Taint) []corev1.Taint {
+		result := make([]corev1.Taint, 0, len(existing))
+		for _, taint := range existing {
+			if taint.Key != CordonTaintKey && taint.Key != DrainTaintKey {
+				result = append(result, taint)
+			}
+		}
+		return result
+	})
+	return err
+}
+
+// PrecheckDrain returns the workloads that would be evicted by draining the cluster.
+func PrecheckDrain(client karmadaclientset.Interface, clusterName string) (*DrainPrecheck, error) {
+	if _, err := client.ClusterV1alpha1().Clusters().Get(context.TODO(), clusterName, metav1.GetOptions{}); err != nil {
+		return nil, err
+	}
+	clusters, err := client.ClusterV1alpha1().Clusters().List(context.TODO(), helpers.ListEverything)
+	if err != nil {
+		return nil, err
+	}
+	bindings, _, err := listBindings(client)
+	if err != nil {
+		return nil, err
+	}
+	return precheckDrain(clusterName, clusters.Items, bindings), nil
+}
+
+func precheckDrain(clusterName string, clusters []clusterv1alpha1.Cluster, bindings []binding) *DrainPrecheck {
+	drainTaint := []corev1.Taint{{Key: DrainTaintKey, Effect: corev1.TaintEffectNoExecute}}
+	affected := affectedWorkloads(clusterName, drainTaint, bindings)
+	bindingMap := make(map[BindingReference]*binding, len(bindings))
+	for i := range bindings {
+		bindingMap[bindings[i].ref] = &bindings[i]
+	}
+
+	result := &DrainPrecheck{
+		Cluster:        clusterName,
+		Workloads:      make([]AffectedWorkload, 0, len(affected)),
+		TargetClusters: make([]string, 0),
+		Sufficient:     true,
+		Shortages:      make([]ResourceShortage, 0),
+	}
+	required := corev1.ResourceList{}
+	for ref, workload := range affected {
+		// drain evicts right away regardless of the toleration seconds.
+		workload.TolerationSeconds = nil
+		result.Workloads = append(result.Workloads, workload)
+		requirements := bindingMap[ref].spec.ReplicaRequirements
+		if requirements == nil {
+			continue
+		}
+		for name, quantity := range requirements.ResourceRequest {
+			total := required[name]
+			for i := int32(0); i < workload.Replicas; i++ {
+				total.Add(quantity)
+			}
+			required[name] = total
+		}
+	}
+	sort.Slice(result.Workloads, func(i, j int) bool {
+		if result.Workloads[i].Binding.Namespace != result.Workloads[j].Binding.Namespace {
+			return result.Workloads[i].Binding.Namespace < result.Workloads[j].Binding.Namespace
+		}
+		return result.Workloads[i].Binding.Name < result.Workloads[j].Binding.Name
+	})
+
+	available := corev1.ResourceList{}
+	for i := range clusters {
+		target := &clusters[i]
+		if target.Name == clusterName || !schedulable(target) {
+			continue
+		}
+		result.TargetClusters = append(result.TargetClusters, target.Name)
+		for name, quantity := range cluster.AvailableResources(target) {
+			total := available[name]
+			total.Add(quantity)
+			available[name] = total
+		}
+	}
+	sort.Strings(result.TargetClusters)
+
+	names := make([]string, 0, len(required))
+	for name := range required {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		quantity := required[corev1.ResourceName(name)]
+		if quantity.Cmp(available[corev1.ResourceName(name)]) > 0 {
+			result.Sufficient = false
+			result.Shortages = append(result.Shortages, ResourceShortage{
+				Name:      corev1.ResourceName(name),
+				Required:  quantity,
+				Available: available[corev1.ResourceName(name)],
+			})
+		}
+	}
+	return result
+}
+
+// schedulable tells whether the cluster is ready and free of taints preventing new workloads.
+func schedulable(cluster *clusterv1alpha1.Cluster) bool {
+	if !meta.IsStatusConditionTrue(cluster.Status.Conditions, clusterv1alpha1.ClusterConditionReady) {
+		return false
+	}
+	for _, taint := range cluster.Spec.Taints {
+		if taint.Effect == corev1.TaintEffectNoSchedule || taint.Effect == corev1.TaintEffectNoExecute {
+			return false
+		}
+	}
+	return true
+}
+
+// GetDrainProgress returns the progress of the last drain of the cluster started by this process.
+func GetDrainProgress(clusterName string) (*DrainProgress, error) {
+	drains.Lock()
+	defer drains.Unlock()
+	progress, ok := drains.progress[clusterName]
+	if !ok {
+		return nil, errors.NewNotFound(fmt.Sprintf("cluster %s has not been drained", clusterName))
+	}
+	snapshot := *progress
+	snapshot.Current = append([]BindingReference{}, progress.Current...)
+	return &snapshot, nil
+}
+
+// StartDrain cordons the cluster and evicts its workloads in batches in the background. Every workload is
+// evicted gracefully, a batch is started only after the previous one has been scheduled to other clusters.
+// If a workload can not be rescheduled the taints are removed so that the scheduler can place it back on the
+// cluster, its replicas there are kept by the graceful eviction until the grace period expires. When all
+// workloads are rescheduled the cluster is tainted with the NoExecute drain taint.
+func StartDrain(client karmadaclientset.Interface, clusterName string, opts DrainOptions) (*DrainProgress, error) {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 5
+	}
+	if opts.BatchInterval < 0 {
+		opts.BatchInterval = 0
+	}
+	if opts.BatchTimeout <= 0 {
+		opts.BatchTimeout = 5 * time.Minute
+	}
+
+	precheck, err := PrecheckDrain(client, clusterName)
+	if err != nil {
+		return nil, err
+	}
+	if !precheck.Sufficient && !opts.Force {
+		shortages := make([]string, 0, len(precheck.Shortages))
+		for _, s := range precheck.Shortages {
+			shortages = append(shortages, fmt.Sprintf("%s requires %s, available %s", s.Name, s.Required.String(), s.Available.String()))
+		}
+		return nil, errors.NewBadRequest(fmt.Sprintf("other clusters lack capacity: %s", strings.Join(shortages, "; ")))
+	}
+
+	drains.Lock()
+	if progress, ok := drains.progress[clusterName]; ok && progress.Phase == DrainRunning {
+		drains.Unlock()
+		return nil, errors.NewBadRequest(fmt.Sprintf("cluster %s is already being drained", clusterName))
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	drains.progress[clusterName] = &DrainProgress{
+		Cluster:   clusterName,
+		Phase:     DrainRunning,
+		Total:     len(precheck.Workloads),
+		Current:   []BindingReference{},
+		StartTime: metav1.Now(),
+	}
+	drains.cancel[clusterName] = cancel
+	drains.Unlock()
+
+	if err := Cordon(client, clusterName); err != nil {
+		cancel()
+		finishDrain(clusterName, DrainFailed, fmt.Sprintf("failed to cordon cluster: %v", err))
+		return nil, err
+	}
+
+	refs := make([]BindingReference, 0, len(precheck.Workloads))
+	for _, workload := range precheck.Workloads {
+		refs = append(refs, workload.Binding)
+	}
+	go func() {
+		defer cancel()
+		drain(ctx, client, clusterName, refs, opts)
+	}()
+	return GetDrainProgress(clusterName)
+}
+
+func drain(ctx context.Context, client karmadaclientset.Interface, clusterName string, refs []BindingReference, opts DrainOptions) {
+	for start := 0; start < len(refs); start += opts.BatchSize {
+		end := start + opts.BatchSize
+		if end > len(refs) {
+			end = len(refs)
+		}
+		batch := refs[start:end]
+		updateDrain(clusterName, func(p *DrainProgress) { p.Current = batch })
+
+		generations := make(map[BindingReference]int64, len(batch))
+		for _, ref := range batch {
+			generation, err := evictBinding(ctx, client, ref, clusterName)
+			if err != nil {
+				stopDrain(ctx, clusterName, DrainFailed, fmt.Sprintf("failed to evict %s %s: %v", ref.Kind, bindingName(ref), err))
+				return
+			}
+			generations[ref] = generation
+			updateDrain(clusterName, func(p *DrainProgress) { p.Evicted++ })
+		}
+
+		var unschedulable string
+		err := wait.PollUntilContextTimeout(ctx, 2*time.Second, opts.BatchTimeout, true, func(ctx context.Context) (bool, error) {
+			for ref, generation := range generations {
+				scheduled, message, err := bindingScheduled(ctx, client, ref, generation)
+				if err != nil {
+					return false, err
+				}
+				if message != "" {
+					unschedulable = fmt.Sprintf("%s %s can not be rescheduled: %s", ref.Kind, bindingName(ref), message)
+					return true, nil
+				}
+				if scheduled {
+					delete(generations, ref)
+					updateDrain(clusterName, func(p *DrainProgress) { p.Rescheduled++ })
+				}
+			}
+			return len(generations) == 0, nil
+		})
+		if unschedulable != "" {
+			if err := removeDrainTaints(client, clusterName); err != nil {
+				stopDrain(ctx, clusterName, DrainFailed, fmt.Sprintf("%s, failed to roll back taints: %v", unschedulable, err))
+				return
+			}
+			stopDrain(ctx, clusterName, DrainRolledBack, unschedulable)
+			return
+		}
+		if err != nil {
+			stopDrain(ctx, clusterName, DrainFailed, fmt.Sprintf("waiting for rescheduling: %v", err))
+			return
+		}
+
+		if end < len(refs) {
+			select {
+			case <-ctx.Done():
+				stopDrain(ctx, clusterName, DrainCancelled, "")
+				return
+			case <-time.After(opts.BatchInterval):
+			}
+		}
+	}
+
+	// cancellation is checked on every attempt of the update, an uncordon either cancels the drain before
+	// the taint is added, or removes the taint afterwards as the conflicting update is retried.
+	_, _, err := updateClusterTaints(client, clusterName, false, func(existing []corev1.Taint) []corev1.Taint {
+		if ctx.Err() != nil {
+			return existing
+		}
+		for _, taint := range existing {
+			if taint.Key == DrainTaintKey && taint.Effect == corev1.TaintEffectNoExecute {
+				return existing
+			}
+		}
+		now := metav1.Now()
+		return append(append([]corev1.Taint{}, existing...), corev1.Taint{Key: DrainTaintKey, Effect: corev1.TaintEffectNoExecute, TimeAdded: &now})
+	})
+	if err != nil {
+		stopDrain(ctx, clusterName, DrainFailed, fmt.Sprintf("failed to add drain taint: %v", err))
+		return
+	}
+	if ctx.Err() != nil {
+		stopDrain(ctx, clusterName, DrainCancelled, "")
+		return
+	}
+	finishDrain(clusterName, DrainSucceeded, "")
+}
+
+// evictBinding gracefully evicts the cluster from the binding like the taint manager of karmada does, the
+// replicas removed from the cluster are scheduled again while those on the other clusters stay. It returns
+// the generation the scheduler has to observe.
+func evictBinding(ctx context.Context, client karmadaclientset.Interface, ref BindingReference, clusterName string) (int64, error) {
+	options := workv1alpha2.NewTaskOptions(
+		workv1alpha2.WithPurgeMode(policyv1alpha1.Graciously),
+		workv1alpha2.WithProducer(drainProducer),
+		workv1alpha2.WithReason("ClusterDrained"),
+		workv1alpha2.WithMessage(fmt.Sprintf("cluster %s is drained", clusterName)),
+	)
+	var generation int64
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if ref.Kind == workv1alpha2.ResourceKindClusterResourceBinding {
+			crb, err := client.WorkV1alpha2().ClusterResourceBindings().Get(ctx, ref.Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			if !crb.Spec.TargetContains(clusterName) {
+				generation = crb.Generation
+				return nil
+			}
+			crb.Spec.GracefulEvictCluster(clusterName, options)
+			crb, err = client.WorkV1alpha2().ClusterResourceBindings().Update(ctx, crb, metav1.UpdateOptions{})
+			if err == nil {
+				generation = crb.Generation
+			}
+			return err
+		}
+		rb, err := client.WorkV1alpha2().ResourceBindings(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if !rb.Spec.TargetContains(clusterName) {
+			generation = rb.Generation
+			return nil
+		}
+		rb.Spec.GracefulEvictCluster(clusterName, options)
+		rb, err = client.WorkV1alpha2().ResourceBindings(ref.Namespace).Update(ctx, rb, metav1.UpdateOptions{})
+		if err == nil {
+			generation = rb.Generation
+		}
+		return err
+	})
+	return generation, err
+}
+
+// bindingScheduled tells whether the scheduler has scheduled the given generation of the binding, or returns
+// the message of the Scheduled condition if the binding can not be scheduled.
+func bindingScheduled(ctx context.Context, client karmadaclientset.Interface, ref BindingReference, generation int64) (bool, string, error) {
+	var status *workv1alpha2.ResourceBindingStatus
+	if ref.Kind == workv1alpha2.ResourceKindClusterResourceBinding {
+		crb, err := client.WorkV1alpha2().ClusterResourceBindings().Get(ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, "", err
+		}
+		status = &crb.Status
+	} else {
+		rb, err := client.WorkV1alpha2().ResourceBindings(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, "", err
+		}
+		status = &rb.Status
+	}
+	if status.SchedulerObservedGeneration < generation {
+		return false, "", nil
+	}
+	condition := meta.FindStatusCondition(status.Conditions, workv1alpha2.Scheduled)
+	if condition == nil {
+		return false, "", nil
+	}
+	if condition.Status != metav1.ConditionTrue &&
+		(condition.Reason == workv1alpha2.BindingReasonNoClusterFit || condition.Reason == workv1alpha2.BindingReasonUnschedulable) {
+		return false, condition.Message, nil
+	}
+	return condition.Status == metav1.ConditionTrue, "", nil
+}
+
+func stopDrain(ctx context.Context, clusterName string, phase DrainPhase, message string) {
+	if ctx.Err() != nil {
+		// uncordoned while draining.
+		phase = DrainCancelled
+		message = ""
+	}
+	klog.InfoS("Cluster drain stopped", "cluster", clusterName, "phase", phase, "message", message)
+	finishDrain(clusterName, phase, message)
+}
+
+func finishDrain(clusterName string, phase DrainPhase, message string) {
+	now := metav1.Now()
+	updateDrain(clusterName, func(p *DrainProgress) {
+		p.Phase = phase
+		p.Message = message
+		p.Current = []BindingReference{}
+		p.CompletionTime = &now
+	})
+	drains.Lock()
+	delete(drains.cancel, clusterName)
+	drains.Unlock()
+}
+
+func updateDrain(clusterName string, update func(p *DrainProgress)) {
+	drains.Lock()
+	defer drains.Unlock()
+	if progress, ok := drains.progress[clusterName]; ok {
+		update(progress)
+	}
+}
+
+func bindingName(ref BindingReference) string {
+	if ref.Namespace == "" {
+		return ref.Name
+	}
+	return ref.Namespace + "/" + ref.Name
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package failover
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestPrecheckDrain(t *testing.T) {
+	readyCluster := func(name, cpu string, taints ...corev1.Taint) clusterv1alpha1.Cluster {
+		return clusterv1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       clusterv1alpha1.ClusterSpec{Taints: taints},
+			Status: clusterv1alpha1.ClusterStatus{
+				Conditions: []metav1.Condition{{Type: clusterv1alpha1.ClusterConditionReady, Status: metav1.ConditionTrue}},
+				ResourceSummary: &clusterv1alpha1.ResourceSummary{
+					Allocatable: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu)},
+					Allocated:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+				},
+			},
+		}
+	}
+	clusters := []clusterv1alpha1.Cluster{
+		readyCluster("member1", "8"),
+		readyCluster("member2", "4"),
+		readyCluster("member3", "16", corev1.Taint{Key: "maintenance", Effect: corev1.TaintEffectNoSchedule}),
+	}
+	bindings := []binding{{
+		ref: BindingReference{Kind: workv1alpha2.ResourceKindResourceBinding, Namespace: "default", Name: "nginx"},
+		spec: &workv1alpha2.ResourceBindingSpec{
+			Resource: workv1alpha2.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "nginx"},
+			ReplicaRequirements: &workv1alpha2.ReplicaRequirements{
+				ResourceRequest: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+			},
+			Clusters: []workv1alpha2.TargetCluster{{Name: "member1", Replicas: 3}, {Name: "member2", Replicas: 1}},
+		},
+		status: &workv1alpha2.ResourceBindingStatus{},
+	}}
+
+	cases := []struct {
+		name           string
+		cluster        string
+		wantWorkloads  int
+		wantTargets    []string
+		wantSufficient bool
+	}{
+		{
+			name:           "other clusters lack capacity",
+			cluster:        "member1",
+			wantWorkloads:  1,
+			wantTargets:    []string{"member2"},
+			wantSufficient: false,
+		},
+		{
+			name:           "enough capacity",
+			cluster:        "member2",
+			wantWorkloads:  1,
+			wantTargets:    []string{"member1"},
+			wantSufficient: true,
+		},
+		{
+			name:           "nothing to evict",
+			cluster:        "member3",
+			wantWorkloads:  0,
+			wantTargets:    []string{"member1", "member2"},
+			wantSufficient: true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := precheckDrain(c.cluster, clusters, bindings)
+			if len(got.Workloads) != c.wantWorkloads {
+				t.Errorf("precheckDrain() workloads = %v, want %d workloads", got.Workloads, c.wantWorkloads)
+			}
+			if !reflect.DeepEqual(got.TargetClusters, c.wantTargets) {
+				t.Errorf("precheckDrain() target clusters = %v, want %v", got.TargetClusters, c.wantTargets)
+			}
+			if got.Sufficient != c.wantSufficient {
+				t.Errorf("precheckDrain() sufficient = %v, want %v, shortages %v", got.Sufficient, c.wantSufficient, got.Shortages)
+			}
+		})
+	}
+}
+
+func TestDrainCancelledBeforeTaint(t *testing.T) {
+	client := fake.NewSimpleClientset(&clusterv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "member1"},
+		Spec: clusterv1alpha1.ClusterSpec{Taints: []corev1.Taint{
+			{Key: CordonTaintKey, Effect: corev1.TaintEffectNoSchedule},
+		}},
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// the cluster is uncordoned right when the drain reads it to add the drain taint
+	client.PrependReactor("get", "clusters", func(k8stesting.Action) (bool, runtime.Object, error) {
+		cancel()
+		return false, nil, nil
+	})
+	drains.Lock()
+	drains.progress["member1"] = &DrainProgress{Cluster: "member1", Phase: DrainRunning}
+	drains.Unlock()
+	defer func() {
+		drains.Lock()
+		delete(drains.progress, "member1")
+		drains.Unlock()
+	}()
+
+	drain(ctx, client, "member1", nil, DrainOptions{BatchSize: 5})
+
+	cluster, err := client.ClusterV1alpha1().Clusters().Get(context.TODO(), "member1", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get cluster: %v", err)
+	}
+	for _, taint := range cluster.Spec.Taints {
+		if taint.Key == DrainTaintKey {
+			t.Errorf("drain taint is added to a cancelled drain: %v", cluster.Spec.Taints)
+		}
+	}
+	if progress, _ := GetDrainProgress("member1"); progress.Phase != DrainCancelled {
+		t.Errorf("drain phase = %s, expected %s", progress.Phase, DrainCancelled)
+	}
+}
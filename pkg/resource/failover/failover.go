@@ -0,0 +1,148 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package failover
+
+import (
+	"context"
+	"sort"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
+	"github.com/karmada-io/dashboard/pkg/resource/policy"
+)
+
+// ClusterFailoverSummary is the failover state of a cluster.
+type ClusterFailoverSummary struct {
+	Name  string                 `json:"name"`
+	Ready metav1.ConditionStatus `json:"ready"`
+	// NoExecuteTaints are the taints that evict workloads not tolerating them from the cluster.
+	NoExecuteTaints []corev1.Taint `json:"noExecuteTaints"`
+	// EvictionTaskCount is the number of graceful eviction tasks still in progress from the cluster.
+	EvictionTaskCount int `json:"evictionTaskCount"`
+}
+
+// FailoverOverview contains the failover state of all clusters.
+type FailoverOverview struct {
+	Clusters []ClusterFailoverSummary `json:"clusters"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// PolicyFailover is the application failover setting of a PropagationPolicy or ClusterPropagationPolicy.
+type PolicyFailover struct {
+	Policy      policy.Key                                  `json:"policy"`
+	Application *policyv1alpha1.ApplicationFailoverBehavior `json:"application"`
+}
+
+// PolicyFailoverList contains the policies with application failover enabled.
+type PolicyFailoverList struct {
+	Items []PolicyFailover `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetFailoverOverview returns the failover state of all clusters in the karmada control-plane.
+func GetFailoverOverview(client karmadaclientset.Interface) (*FailoverOverview, error) {
+	clusters, err := client.ClusterV1alpha1().Clusters().List(context.TODO(), helpers.ListEverything)
+	if err != nil {
+		return nil, err
+	}
+	bindings, nonCriticalErrors, err := listBindings(client)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &FailoverOverview{
+		Clusters: make([]ClusterFailoverSummary, 0, len(clusters.Items)),
+		Errors:   nonCriticalErrors,
+	}
+	for i := range clusters.Items {
+		result.Clusters = append(result.Clusters, toClusterFailoverSummary(&clusters.Items[i], len(evictionTasksFrom(clusters.Items[i].Name, bindings))))
+	}
+	return result, nil
+}
+
+// GetPolicyFailoverList returns the PropagationPolicies and ClusterPropagationPolicies with application failover enabled.
+func GetPolicyFailoverList(client karmadaclientset.Interface) (*PolicyFailoverList, error) {
+	propagationPolicies, err := client.PolicyV1alpha1().PropagationPolicies("").List(context.TODO(), helpers.ListEverything)
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+	clusterPropagationPolicies, err := client.PolicyV1alpha1().ClusterPropagationPolicies().List(context.TODO(), helpers.ListEverything)
+	nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	result := &PolicyFailoverList{
+		Items:  make([]PolicyFailover, 0),
+		Errors: nonCriticalErrors,
+	}
+	for _, pp := range propagationPolicies.Items {
+		if pp.Spec.Failover != nil && pp.Spec.Failover.Application != nil {
+			result.Items = append(result.Items, PolicyFailover{
+				Policy:      policy.PropagationPolicyKey(false, pp.Namespace, pp.Name),
+				Application: pp.Spec.Failover.Application,
+			})
+		}
+	}
+	for _, cpp := range clusterPropagationPolicies.Items {
+		if cpp.Spec.Failover != nil && cpp.Spec.Failover.Application != nil {
+			result.Items = append(result.Items, PolicyFailover{
+				Policy:      policy.PropagationPolicyKey(true, "", cpp.Name),
+				Application: cpp.Spec.Failover.Application,
+			})
+		}
+	}
+	sort.Slice(result.Items, func(i, j int) bool {
+		return result.Items[i].Policy.String() < result.Items[j].Policy.String()
+	})
+	return result, nil
+}
+
+func toClusterFailoverSummary(cluster *clusterv1alpha1.Cluster, evictionTaskCount int) ClusterFailoverSummary {
+	ready := metav1.ConditionUnknown
+	if condition := meta.FindStatusCondition(cluster.Status.Conditions, clusterv1alpha1.ClusterConditionReady); condition != nil {
+		ready = condition.Status
+	}
+	return ClusterFailoverSummary{
+		Name:              cluster.Name,
+		Ready:             ready,
+		NoExecuteTaints:   noExecuteTaints(cluster.Spec.Taints),
+		EvictionTaskCount: evictionTaskCount,
+	}
+}
+
+func noExecuteTaints(taints []corev1.Taint) []corev1.Taint {
+	result := make([]corev1.Taint, 0)
+	for _, taint := range taints {
+		if taint.Effect == corev1.TaintEffectNoExecute {
+			result = append(result, taint)
+		}
+	}
+	return result
+}
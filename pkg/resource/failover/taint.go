@@ -0,0 +1,228 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package failover
+
+import (
+	"context"
+	"sort"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/util/helper"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+// AffectedWorkload is a workload on a cluster whose eviction is changed by the eviction taints.
+type AffectedWorkload struct {
+	Workload workv1alpha2.ObjectReference `json:"workload"`
+	Binding  BindingReference             `json:"binding"`
+	// Replicas is the number of replicas of the workload in the cluster.
+	Replicas int32 `json:"replicas"`
+	// TolerationSeconds is how long the workload tolerates the taints before being evicted,
+	// nil means the workload is evicted immediately.
+	TolerationSeconds *int64 `json:"tolerationSeconds,omitempty"`
+}
+
+// EvictionTaintResult is the result of adding or removing eviction taints of a cluster.
+type EvictionTaintResult struct {
+	Cluster string `json:"cluster"`
+	DryRun  bool   `json:"dryRun"`
+	// Taints are the taints of the cluster after the change.
+	Taints []corev1.Taint `json:"taints"`
+	// AffectedWorkloads are the workloads that start being evicted when adding taints, or the workloads
+	// that are no longer evicted when removing taints.
+	AffectedWorkloads []AffectedWorkload `json:"affectedWorkloads"`
+}
+
+// AddEvictionTaints adds NoExecute taints to the cluster, a taint replaces the existing taint with the same key
+// and effect. With dryRun the cluster is left unchanged and only the affected workloads are reported.
+func AddEvictionTaints(client karmadaclientset.Interface, clusterName string, taints []corev1.Taint, dryRun bool) (*EvictionTaintResult, error) {
+	return updateEvictionTaints(client, clusterName, dryRun, func(existing []corev1.Taint) []corev1.Taint {
+		now := metav1.Now()
+		result := make([]corev1.Taint, 0, len(existing)+len(taints))
+		for _, taint := range existing {
+			if taint.Effect != corev1.TaintEffectNoExecute || !containsKey(taints, taint.Key) {
+				result = append(result, taint)
+			}
+		}
+		for _, taint := range taints {
+			taint.Effect = corev1.TaintEffectNoExecute
+			taint.TimeAdded = &now
+			result = append(result, taint)
+		}
+		return result
+	})
+}
+
+// RemoveEvictionTaints removes the NoExecute taints with the given keys from the cluster. With dryRun the
+// cluster is left unchanged and only the affected workloads are reported.
+func RemoveEvictionTaints(client karmadaclientset.Interface, clusterName string, keys []string, dryRun bool) (*EvictionTaintResult, error) {
+	return updateEvictionTaints(client, clusterName, dryRun, func(existing []corev1.Taint) []corev1.Taint {
+		result := make([]corev1.Taint, 0, len(existing))
+		for _, taint := range existing {
+			if taint.Effect != corev1.TaintEffectNoExecute || !containsString(keys, taint.Key) {
+				result = append(result, taint)
+			}
+		}
+		return result
+	})
+}
+
+func updateEvictionTaints(client karmadaclientset.Interface, clusterName string, dryRun bool, mutate func([]corev1.Taint) []corev1.Taint) (*EvictionTaintResult, error) {
+	bindings, _, err := listBindings(client)
+	if err != nil {
+		return nil, err
+	}
+	before, after, err := updateClusterTaints(client, clusterName, dryRun, mutate)
+	if err != nil {
+		return nil, err
+	}
+	return &EvictionTaintResult{
+		Cluster: clusterName,
+		DryRun:  dryRun,
+		Taints:  after,
+		AffectedWorkloads: diffAffectedWorkloads(
+			affectedWorkloads(clusterName, noExecuteTaints(before), bindings),
+			affectedWorkloads(clusterName, noExecuteTaints(after), bindings)),
+	}, nil
+}
+
+// updateClusterTaints replaces the taints of the cluster with the result of mutate, retrying on conflict.
+// It returns the taints before and after the change, with dryRun the cluster is left unchanged.
+func updateClusterTaints(client karmadaclientset.Interface, clusterName string, dryRun bool, mutate func([]corev1.Taint) []corev1.Taint) ([]corev1.Taint, []corev1.Taint, error) {
+	var before, after []corev1.Taint
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cluster, err := client.ClusterV1alpha1().Clusters().Get(context.TODO(), clusterName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		before = cluster.Spec.Taints
+		after = mutate(before)
+		if dryRun {
+			return nil
+		}
+		cluster.Spec.Taints = after
+		_, err = client.ClusterV1alpha1().Clusters().Update(context.TODO(), cluster, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return before, after, nil
+}
+
+// affectedWorkloads returns the workloads on the cluster that would be evicted by the NoExecute taints,
+// it follows the decision of karmada's taint manager.
+func affectedWorkloads(clusterName string, taints []corev1.Taint, bindings []binding) map[BindingReference]AffectedWorkload {
+	result := make(map[BindingReference]AffectedWorkload)
+	if len(taints) == 0 {
+		return result
+	}
+	for i := range bindings {
+		target := bindings[i].scheduledTo(clusterName)
+		if target == nil {
+			continue
+		}
+		var tolerations []corev1.Toleration
+		if bindings[i].spec.Placement != nil {
+			tolerations = bindings[i].spec.Placement.ClusterTolerations
+		}
+		affected := AffectedWorkload{
+			Workload: bindings[i].spec.Resource,
+			Binding:  bindings[i].ref,
+			Replicas: target.Replicas,
+		}
+		if tolerated, used := helper.GetMatchingTolerations(taints, tolerations); tolerated {
+			affected.TolerationSeconds = minTolerationSeconds(used)
+			if affected.TolerationSeconds == nil {
+				// tolerates the taints forever.
+				continue
+			}
+		}
+		result[bindings[i].ref] = affected
+	}
+	return result
+}
+
+// diffAffectedWorkloads returns the workloads whose eviction differs between before and after, taking the
+// eviction from after, or from before if the workload is no longer evicted.
+func diffAffectedWorkloads(before, after map[BindingReference]AffectedWorkload) []AffectedWorkload {
+	result := make([]AffectedWorkload, 0)
+	for ref, workload := range after {
+		if previous, ok := before[ref]; !ok || !equalSeconds(previous.TolerationSeconds, workload.TolerationSeconds) {
+			result = append(result, workload)
+		}
+	}
+	for ref, workload := range before {
+		if _, ok := after[ref]; !ok {
+			result = append(result, workload)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Binding.Namespace != result[j].Binding.Namespace {
+			return result[i].Binding.Namespace < result[j].Binding.Namespace
+		}
+		return result[i].Binding.Name < result[j].Binding.Name
+	})
+	return result
+}
+
+// minTolerationSeconds returns the shortest toleration seconds of the tolerations, nil means forever.
+func minTolerationSeconds(tolerations []corev1.Toleration) *int64 {
+	var result *int64
+	for i := range tolerations {
+		seconds := tolerations[i].TolerationSeconds
+		if seconds == nil {
+			continue
+		}
+		if *seconds <= 0 {
+			zero := int64(0)
+			return &zero
+		}
+		if result == nil || *seconds < *result {
+			result = seconds
+		}
+	}
+	return result
+}
+
+func equalSeconds(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func containsKey(taints []corev1.Taint, key string) bool {
+	for _, taint := range taints {
+		if taint.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(s []string, e string) bool {
+	for _, a := range s {
+		if a == e {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package failover
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAddEvictionTaints(t *testing.T) {
+	seconds := int64(300)
+	newBinding := func(name string, tolerations ...corev1.Toleration) *workv1alpha2.ResourceBinding {
+		return &workv1alpha2.ResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Spec: workv1alpha2.ResourceBindingSpec{
+				Resource:  workv1alpha2.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: name},
+				Placement: &policyv1alpha1.Placement{ClusterTolerations: tolerations},
+				Clusters:  []workv1alpha2.TargetCluster{{Name: "member1", Replicas: 2}},
+			},
+		}
+	}
+	ref := func(name string) BindingReference {
+		return BindingReference{Kind: workv1alpha2.ResourceKindResourceBinding, Namespace: "default", Name: name}
+	}
+	workload := func(name string) workv1alpha2.ObjectReference {
+		return workv1alpha2.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: name}
+	}
+
+	cases := []struct {
+		name   string
+		dryRun bool
+		want   []AffectedWorkload
+	}{
+		{
+			name:   "dry run",
+			dryRun: true,
+			want: []AffectedWorkload{
+				{Workload: workload("delayed"), Binding: ref("delayed"), Replicas: 2, TolerationSeconds: &seconds},
+				{Workload: workload("evicted"), Binding: ref("evicted"), Replicas: 2},
+			},
+		},
+		{
+			name: "apply",
+			want: []AffectedWorkload{
+				{Workload: workload("delayed"), Binding: ref("delayed"), Replicas: 2, TolerationSeconds: &seconds},
+				{Workload: workload("evicted"), Binding: ref("evicted"), Replicas: 2},
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			client := karmadafake.NewSimpleClientset(
+				&clusterv1alpha1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "member1"},
+					Spec: clusterv1alpha1.ClusterSpec{Taints: []corev1.Taint{
+						{Key: "maintenance", Effect: corev1.TaintEffectNoSchedule},
+					}},
+				},
+				newBinding("evicted"),
+				newBinding("delayed", corev1.Toleration{Key: "outage", Operator: corev1.TolerationOpExists, TolerationSeconds: &seconds}),
+				newBinding("tolerated", corev1.Toleration{Operator: corev1.TolerationOpExists}),
+			)
+
+			result, err := AddEvictionTaints(client, "member1", []corev1.Taint{{Key: "outage"}}, c.dryRun)
+			if err != nil {
+				t.Fatalf("AddEvictionTaints() error = %v", err)
+			}
+			if !reflect.DeepEqual(result.AffectedWorkloads, c.want) {
+				t.Errorf("AddEvictionTaints() affected workloads = %v, want %v", result.AffectedWorkloads, c.want)
+			}
+
+			cluster, err := client.ClusterV1alpha1().Clusters().Get(context.TODO(), "member1", metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			wantTaints := 2
+			if c.dryRun {
+				wantTaints = 1
+			}
+			if len(cluster.Spec.Taints) != wantTaints {
+				t.Errorf("cluster taints = %v, want %d taints", cluster.Spec.Taints, wantTaints)
+			}
+
+			removed, err := RemoveEvictionTaints(client, "member1", []string{"outage"}, true)
+			if err != nil {
+				t.Fatalf("RemoveEvictionTaints() error = %v", err)
+			}
+			if !c.dryRun && !reflect.DeepEqual(removed.AffectedWorkloads, c.want) {
+				t.Errorf("RemoveEvictionTaints() affected workloads = %v, want %v", removed.AffectedWorkloads, c.want)
+			}
+		})
+	}
+}
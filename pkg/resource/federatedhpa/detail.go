@@ -0,0 +1,76 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package federatedhpa
+
+import (
+	"context"
+
+	autoscalingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/autoscaling/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+)
+
+// FederatedHPADetail is a presentation layer view of Karmada FederatedHPA resource.
+type FederatedHPADetail struct {
+	// Extends list item structure.
+	FederatedHPA `json:",inline"`
+
+	Metrics        []autoscalingv2.MetricSpec                       `json:"metrics"`
+	Behavior       *autoscalingv2.HorizontalPodAutoscalerBehavior   `json:"behavior"`
+	CurrentMetrics []autoscalingv2.MetricStatus                     `json:"currentMetrics"`
+	Conditions     []autoscalingv2.HorizontalPodAutoscalerCondition `json:"conditions"`
+	LastScaleTime  *metaV1.Time                                     `json:"lastScaleTime"`
+	// Distribution is the replicas of target workload in every member cluster.
+	Distribution []workv1alpha2.TargetCluster `json:"distribution"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetFederatedHPADetail gets FederatedHPA details.
+func GetFederatedHPADetail(client karmadaclientset.Interface, namespace, name string) (*FederatedHPADetail, error) {
+	hpa, err := client.AutoscalingV1alpha1().FederatedHPAs(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	distribution, err := common.GetWorkloadDistribution(client, namespace, hpa.Spec.ScaleTargetRef.Kind, hpa.Spec.ScaleTargetRef.Name)
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	return toFederatedHPADetail(hpa, distribution, nonCriticalErrors), nil
+}
+
+func toFederatedHPADetail(hpa *autoscalingv1alpha1.FederatedHPA, distribution []workv1alpha2.TargetCluster, nonCriticalErrors []error) *FederatedHPADetail {
+	return &FederatedHPADetail{
+		FederatedHPA:   toFederatedHPA(hpa),
+		Metrics:        hpa.Spec.Metrics,
+		Behavior:       hpa.Spec.Behavior,
+		CurrentMetrics: hpa.Status.CurrentMetrics,
+		Conditions:     hpa.Status.Conditions,
+		LastScaleTime:  hpa.Status.LastScaleTime,
+		Distribution:   distribution,
+		Errors:         nonCriticalErrors,
+	}
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package federatedhpa
+
+import (
+	"strings"
+
+	autoscalingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/autoscaling/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/util/lifted"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/karmada-io/dashboard/pkg/common/types"
+)
+
+// ValidateFederatedHPA validates the name, namespace and spec of FederatedHPA like the webhook of karmada
+// does, and rejects a scale target which is an autoscaler itself.
+func ValidateFederatedHPA(namespace, name string, spec *autoscalingv1alpha1.FederatedHPASpec) field.ErrorList {
+	allErrs := lifted.ValidateFederatedHPA(&autoscalingv1alpha1.FederatedHPA{
+		ObjectMeta: metaV1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec:       *spec,
+	})
+	switch types.ResourceKind(strings.ToLower(spec.ScaleTargetRef.Kind)) {
+	case types.ResourceKindHorizontalPodAutoscaler, types.ResourceKindFederatedHPA, types.ResourceKindCronFederatedHPA:
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec").Child("scaleTargetRef").Child("kind"),
+			spec.ScaleTargetRef.Kind, "must be a workload, not an autoscaler"))
+	}
+	return allErrs
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package federatedhpa
+
+import (
+	"reflect"
+	"testing"
+
+	autoscalingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/autoscaling/v1alpha1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	"k8s.io/utils/ptr"
+)
+
+func TestValidateFederatedHPA(t *testing.T) {
+	deployment := autoscalingv2.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "nginx"}
+	cases := []struct {
+		name     string
+		spec     autoscalingv1alpha1.FederatedHPASpec
+		expected []string
+	}{
+		{name: "valid", spec: autoscalingv1alpha1.FederatedHPASpec{ScaleTargetRef: deployment, MinReplicas: ptr.To[int32](1), MaxReplicas: 3}},
+		{
+			name:     "min replicas greater than max",
+			spec:     autoscalingv1alpha1.FederatedHPASpec{ScaleTargetRef: deployment, MinReplicas: ptr.To[int32](4), MaxReplicas: 3},
+			expected: []string{"spec.maxReplicas"},
+		},
+		{
+			name:     "zero replicas",
+			spec:     autoscalingv1alpha1.FederatedHPASpec{ScaleTargetRef: deployment, MinReplicas: ptr.To[int32](0)},
+			expected: []string{"spec.minReplicas", "spec.maxReplicas"},
+		},
+		{
+			name:     "missing scale target",
+			spec:     autoscalingv1alpha1.FederatedHPASpec{MaxReplicas: 3},
+			expected: []string{"spec.scaleTargetRef.kind", "spec.scaleTargetRef.name"},
+		},
+		{
+			name: "autoscaler as scale target",
+			spec: autoscalingv1alpha1.FederatedHPASpec{MaxReplicas: 3, ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: "autoscaling/v2", Kind: "HorizontalPodAutoscaler", Name: "nginx",
+			}},
+			expected: []string{"spec.scaleTargetRef.kind"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual []string
+			for _, err := range ValidateFederatedHPA("default", "nginx", &tc.spec) {
+				actual = append(actual, err.Field)
+			}
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("ValidateFederatedHPA() = %v, expected %v", actual, tc.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package federatedresourcequota
+
+import (
+	"context"
+
+	"github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FederatedResourceQuotaDetail is a presentation layer view of Karmada FederatedResourceQuota resource.
+type FederatedResourceQuotaDetail struct {
+	// Extends list item structure.
+	FederatedResourceQuota `json:",inline"`
+
+	Overall           v1.ResourceList                    `json:"overall"`
+	StaticAssignments []v1alpha1.StaticClusterAssignment `json:"staticAssignments"`
+	// AggregatedStatus is the hard limits and usage of the quota in every member cluster.
+	AggregatedStatus []v1alpha1.ClusterQuotaStatus `json:"aggregatedStatus"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetFederatedResourceQuotaDetail gets federated resource quota details.
+func GetFederatedResourceQuotaDetail(client karmadaclientset.Interface, namespace, name string) (*FederatedResourceQuotaDetail, error) {
+	quota, err := client.PolicyV1alpha1().FederatedResourceQuotas(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return toFederatedResourceQuotaDetail(quota), nil
+}
+
+func toFederatedResourceQuotaDetail(quota *v1alpha1.FederatedResourceQuota) *FederatedResourceQuotaDetail {
+	return &FederatedResourceQuotaDetail{
+		FederatedResourceQuota: toFederatedResourceQuota(quota),
+		Overall:                quota.Spec.Overall,
+		StaticAssignments:      quota.Spec.StaticAssignments,
+		AggregatedStatus:       quota.Status.AggregatedStatus,
+		Errors:                 []error{},
+	}
+}
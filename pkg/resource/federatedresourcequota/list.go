@@ -0,0 +1,123 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package federatedresourcequota
+
+import (
+	"context"
+	"sort"
+
+	"github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+)
+
+// FederatedResourceQuotaList contains a list of federated resource quotas in the karmada control-plane.
+type FederatedResourceQuotaList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+
+	// Unordered list of FederatedResourceQuotas.
+	Items []FederatedResourceQuota `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// FederatedResourceQuota contains information about a single federated resource quota.
+type FederatedResourceQuota struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+	// Usage is the overall hard limits vs used aggregated across member clusters.
+	Usage []ResourceUsage `json:"usage"`
+}
+
+// ResourceUsage describes the usage of a single resource of a federated resource quota.
+type ResourceUsage struct {
+	Name v1.ResourceName `json:"name"`
+	// Hard is the overall limit of the resource.
+	Hard resource.Quantity `json:"hard"`
+	// Assigned is the sum of the static assignments of the resource.
+	Assigned resource.Quantity `json:"assigned"`
+	// Used is the sum of usage of the resource in all member clusters.
+	Used resource.Quantity `json:"used"`
+}
+
+// GetFederatedResourceQuotaList returns a list of all federated resource quotas in the karmada control-plane.
+func GetFederatedResourceQuotaList(client karmadaclientset.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*FederatedResourceQuotaList, error) {
+	quotas, err := client.PolicyV1alpha1().FederatedResourceQuotas(nsQuery.ToRequestParam()).List(context.TODO(), helpers.ListEverything)
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	return toFederatedResourceQuotaList(quotas.Items, nonCriticalErrors, dsQuery), nil
+}
+
+func toFederatedResourceQuotaList(quotas []v1alpha1.FederatedResourceQuota, nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery) *FederatedResourceQuotaList {
+	result := &FederatedResourceQuotaList{
+		Items:    make([]FederatedResourceQuota, 0),
+		ListMeta: types.ListMeta{TotalItems: len(quotas)},
+		Errors:   nonCriticalErrors,
+	}
+
+	quotaCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(quotas), dsQuery)
+	quotas = fromCells(quotaCells)
+	result.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+
+	for i := range quotas {
+		result.Items = append(result.Items, toFederatedResourceQuota(&quotas[i]))
+	}
+	return result
+}
+
+func toFederatedResourceQuota(quota *v1alpha1.FederatedResourceQuota) FederatedResourceQuota {
+	return FederatedResourceQuota{
+		ObjectMeta: types.NewObjectMeta(quota.ObjectMeta),
+		TypeMeta:   types.NewTypeMeta(types.ResourceKindFederatedResourceQuota),
+		Usage:      aggregateUsage(quota),
+	}
+}
+
+// aggregateUsage sums up the static assignments and the usage reported by member clusters for
+// every resource limited by the quota.
+func aggregateUsage(quota *v1alpha1.FederatedResourceQuota) []ResourceUsage {
+	usage := make([]ResourceUsage, 0, len(quota.Spec.Overall))
+	for name, hard := range quota.Spec.Overall {
+		item := ResourceUsage{Name: name, Hard: hard.DeepCopy()}
+		for _, assignment := range quota.Spec.StaticAssignments {
+			if q, ok := assignment.Hard[name]; ok {
+				item.Assigned.Add(q)
+			}
+		}
+		for _, status := range quota.Status.AggregatedStatus {
+			if q, ok := status.Used[name]; ok {
+				item.Used.Add(q)
+			}
+		}
+		usage = append(usage, item)
+	}
+	sort.Slice(usage, func(i, j int) bool {
+		return usage[i].Name < usage[j].Name
+	})
+	return usage
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package federatedresourcequota
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestAggregateUsage(t *testing.T) {
+	quota := &v1alpha1.FederatedResourceQuota{
+		Spec: v1alpha1.FederatedResourceQuotaSpec{
+			Overall: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse("10"),
+				v1.ResourceMemory: resource.MustParse("10Gi"),
+			},
+			StaticAssignments: []v1alpha1.StaticClusterAssignment{
+				{ClusterName: "member1", Hard: v1.ResourceList{v1.ResourceCPU: resource.MustParse("4")}},
+				{ClusterName: "member2", Hard: v1.ResourceList{v1.ResourceCPU: resource.MustParse("2")}},
+			},
+		},
+		Status: v1alpha1.FederatedResourceQuotaStatus{
+			AggregatedStatus: []v1alpha1.ClusterQuotaStatus{
+				{ClusterName: "member1", ResourceQuotaStatus: v1.ResourceQuotaStatus{
+					Used: v1.ResourceList{v1.ResourceCPU: resource.MustParse("1500m")},
+				}},
+				{ClusterName: "member2", ResourceQuotaStatus: v1.ResourceQuotaStatus{
+					Used: v1.ResourceList{v1.ResourceCPU: resource.MustParse("500m")},
+				}},
+			},
+		},
+	}
+	expected := map[v1.ResourceName][3]string{
+		v1.ResourceCPU:    {"10", "6", "2"},
+		v1.ResourceMemory: {"10Gi", "0", "0"},
+	}
+	actual := make(map[v1.ResourceName][3]string)
+	for _, usage := range aggregateUsage(quota) {
+		actual[usage.Name] = [3]string{usage.Hard.String(), usage.Assigned.String(), usage.Used.String()}
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("aggregateUsage() == %v, expected %v", actual, expected)
+	}
+}
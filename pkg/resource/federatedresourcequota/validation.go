@@ -0,0 +1,72 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package federatedresourcequota
+
+import (
+	"fmt"
+
+	"github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateFederatedResourceQuotaSpec validates the spec of a federated resource quota, the static
+// assignments must reference distinct clusters, only limit resources limited by overall, and must
+// not exceed overall in total.
+func ValidateFederatedResourceQuotaSpec(spec *v1alpha1.FederatedResourceQuotaSpec) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+	if len(spec.Overall) == 0 {
+		allErrs = append(allErrs, field.Required(specPath.Child("overall"), "at least one resource must be limited"))
+	}
+	for name, q := range spec.Overall {
+		if q.Sign() < 0 {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("overall").Key(string(name)), q.String(), "must be greater than or equal to 0"))
+		}
+	}
+
+	clusters := make(map[string]bool)
+	assigned := make(v1.ResourceList)
+	for i, assignment := range spec.StaticAssignments {
+		fldPath := specPath.Child("staticAssignments").Index(i)
+		if assignment.ClusterName == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("clusterName"), ""))
+		} else if clusters[assignment.ClusterName] {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("clusterName"), assignment.ClusterName))
+		}
+		clusters[assignment.ClusterName] = true
+		for name, q := range assignment.Hard {
+			if _, ok := spec.Overall[name]; !ok {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("hard").Key(string(name)), q.String(), "resource is not limited by overall"))
+				continue
+			}
+			if q.Sign() < 0 {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("hard").Key(string(name)), q.String(), "must be greater than or equal to 0"))
+			}
+			total := assigned[name]
+			total.Add(q)
+			assigned[name] = total
+		}
+	}
+	for name, total := range assigned {
+		if overall := spec.Overall[name]; total.Cmp(overall) > 0 {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("staticAssignments"), total.String(),
+				fmt.Sprintf("sum of %s assigned to clusters exceeds overall %s", name, overall.String())))
+		}
+	}
+	return allErrs
+}
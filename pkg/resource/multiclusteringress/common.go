@@ -0,0 +1,57 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiclusteringress
+
+import (
+	networkingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/networking/v1alpha1"
+
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+)
+
+// MultiClusterIngressCell wraps networkingv1alpha1.MultiClusterIngress for data selection.
+type MultiClusterIngressCell networkingv1alpha1.MultiClusterIngress
+
+// GetProperty returns a property of the multi-cluster ingress cell.
+func (c MultiClusterIngressCell) GetProperty(name dataselect.PropertyName) dataselect.ComparableValue {
+	switch name {
+	case dataselect.NameProperty:
+		return dataselect.StdComparableString(c.ObjectMeta.Name)
+	case dataselect.CreationTimestampProperty:
+		return dataselect.StdComparableTime(c.ObjectMeta.CreationTimestamp.Time)
+	case dataselect.NamespaceProperty:
+		return dataselect.StdComparableString(c.ObjectMeta.Namespace)
+	default:
+		// if name is not supported then just return a constant dummy value, sort will have no effect.
+		return nil
+	}
+}
+
+func toCells(std []networkingv1alpha1.MultiClusterIngress) []dataselect.DataCell {
+	cells := make([]dataselect.DataCell, len(std))
+	for i := range std {
+		cells[i] = MultiClusterIngressCell(std[i])
+	}
+	return cells
+}
+
+func fromCells(cells []dataselect.DataCell) []networkingv1alpha1.MultiClusterIngress {
+	std := make([]networkingv1alpha1.MultiClusterIngress, len(cells))
+	for i := range std {
+		std[i] = networkingv1alpha1.MultiClusterIngress(cells[i].(MultiClusterIngressCell))
+	}
+	return std
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiclusteringress
+
+import (
+	"context"
+	"sort"
+
+	networkingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/networking/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	networkingv1 "k8s.io/api/networking/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
+)
+
+// MultiClusterIngressDetail is a presentation layer view of Karmada MultiClusterIngress resource.
+type MultiClusterIngressDetail struct {
+	// Extends list item structure.
+	MultiClusterIngress `json:",inline"`
+
+	Spec   networkingv1.IngressSpec   `json:"spec"`
+	Status networkingv1.IngressStatus `json:"status"`
+	// Backends are the services referenced by the ingress.
+	Backends []IngressBackend `json:"backends"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// IngressBackend is a service referenced by a MultiClusterIngress.
+type IngressBackend struct {
+	ServiceName string `json:"serviceName"`
+	// HasMultiClusterService tells whether the service is exposed by a MultiClusterService, the
+	// ingress can only route traffic to services of other clusters through MultiClusterService.
+	HasMultiClusterService bool `json:"hasMultiClusterService"`
+}
+
+// GetMultiClusterIngressDetail gets MultiClusterIngress details.
+func GetMultiClusterIngressDetail(client karmadaclientset.Interface, namespace, name string) (*MultiClusterIngressDetail, error) {
+	mci, err := client.NetworkingV1alpha1().MultiClusterIngresses(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	mcsList, err := client.NetworkingV1alpha1().MultiClusterServices(namespace).List(context.TODO(), helpers.ListEverything)
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+	exposed := make(map[string]bool)
+	if mcsList != nil {
+		for _, mcs := range mcsList.Items {
+			exposed[mcs.Name] = true
+		}
+	}
+
+	return &MultiClusterIngressDetail{
+		MultiClusterIngress: toMultiClusterIngress(mci),
+		Spec:                mci.Spec,
+		Status:              mci.Status.IngressStatus,
+		Backends:            toBackends(mci, exposed),
+		Errors:              nonCriticalErrors,
+	}, nil
+}
+
+func toBackends(mci *networkingv1alpha1.MultiClusterIngress, exposed map[string]bool) []IngressBackend {
+	services := make(map[string]bool)
+	addBackend := func(backend *networkingv1.IngressBackend) {
+		if backend != nil && backend.Service != nil {
+			services[backend.Service.Name] = true
+		}
+	}
+	addBackend(mci.Spec.DefaultBackend)
+	for _, rule := range mci.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for i := range rule.HTTP.Paths {
+			addBackend(&rule.HTTP.Paths[i].Backend)
+		}
+	}
+	backends := make([]IngressBackend, 0, len(services))
+	for service := range services {
+		backends = append(backends, IngressBackend{ServiceName: service, HasMultiClusterService: exposed[service]})
+	}
+	sort.Slice(backends, func(i, j int) bool {
+		return backends[i].ServiceName < backends[j].ServiceName
+	})
+	return backends
+}
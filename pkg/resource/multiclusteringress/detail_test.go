@@ -0,0 +1,93 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiclusteringress
+
+import (
+	"reflect"
+	"testing"
+
+	networkingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/networking/v1alpha1"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestGetMultiClusterIngressDetail(t *testing.T) {
+	backend := func(service string) networkingv1.IngressBackend {
+		return networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{Name: service}}
+	}
+	path := func(service string) networkingv1.HTTPIngressPath {
+		return networkingv1.HTTPIngressPath{Path: "/" + service, Backend: backend(service)}
+	}
+	defaultBackend := backend("web")
+	mci := &networkingv1alpha1.MultiClusterIngress{
+		ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "shop"},
+		Spec: networkingv1.IngressSpec{
+			DefaultBackend: &defaultBackend,
+			Rules: []networkingv1.IngressRule{
+				{Host: "shop.example.io", IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+					Paths: []networkingv1.HTTPIngressPath{path("web"), path("cart")},
+				}}},
+				// a rule without http paths has no backend
+				{Host: "empty.example.io"},
+			},
+		},
+	}
+	mcs := &networkingv1alpha1.MultiClusterService{ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "cart"}}
+
+	cases := []struct {
+		name         string
+		forbidMCS    bool
+		wantBackends []IngressBackend
+		wantErrors   int
+	}{
+		{
+			name:         "backends exposed by MultiClusterService",
+			wantBackends: []IngressBackend{{ServiceName: "cart", HasMultiClusterService: true}, {ServiceName: "web"}},
+		},
+		{
+			name:         "MultiClusterServices forbidden",
+			forbidMCS:    true,
+			wantBackends: []IngressBackend{{ServiceName: "cart"}, {ServiceName: "web"}},
+			wantErrors:   1,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			karmadaClient := karmadafake.NewSimpleClientset(mci, mcs)
+			if tc.forbidMCS {
+				karmadaClient.PrependReactor("list", "multiclusterservices", func(k8stesting.Action) (bool, runtime.Object, error) {
+					return true, nil, k8serrors.NewForbidden(schema.GroupResource{Group: networkingv1alpha1.GroupName, Resource: "multiclusterservices"}, "", nil)
+				})
+			}
+			detail, err := GetMultiClusterIngressDetail(karmadaClient, "default", "shop")
+			if err != nil {
+				t.Fatalf("GetMultiClusterIngressDetail() error = %v", err)
+			}
+			if !reflect.DeepEqual(detail.Backends, tc.wantBackends) {
+				t.Errorf("GetMultiClusterIngressDetail() backends = %+v, want %+v", detail.Backends, tc.wantBackends)
+			}
+			if len(detail.Errors) != tc.wantErrors {
+				t.Errorf("GetMultiClusterIngressDetail() errors = %v, want %d", detail.Errors, tc.wantErrors)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiclusteringress
+
+import (
+	"context"
+
+	networkingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/networking/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+)
+
+// MultiClusterIngressList contains a list of MultiClusterIngresses in the karmada control-plane.
+type MultiClusterIngressList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+
+	// Unordered list of MultiClusterIngresses.
+	Items []MultiClusterIngress `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// MultiClusterIngress contains information about a single MultiClusterIngress.
+type MultiClusterIngress struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+	// Hosts are the hosts of all rules of the ingress.
+	Hosts []string `json:"hosts"`
+}
+
+// GetMultiClusterIngressList returns a list of all MultiClusterIngresses in the karmada control-plane.
+func GetMultiClusterIngressList(client karmadaclientset.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*MultiClusterIngressList, error) {
+	mciList, err := client.NetworkingV1alpha1().MultiClusterIngresses(nsQuery.ToRequestParam()).List(context.TODO(), helpers.ListEverything)
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	return toMultiClusterIngressList(mciList.Items, nonCriticalErrors, dsQuery), nil
+}
+
+func toMultiClusterIngressList(ingresses []networkingv1alpha1.MultiClusterIngress, nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery) *MultiClusterIngressList {
+	result := &MultiClusterIngressList{
+		Items:    make([]MultiClusterIngress, 0),
+		ListMeta: types.ListMeta{TotalItems: len(ingresses)},
+		Errors:   nonCriticalErrors,
+	}
+
+	ingressCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(ingresses), dsQuery)
+	ingresses = fromCells(ingressCells)
+	result.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+
+	for i := range ingresses {
+		result.Items = append(result.Items, toMultiClusterIngress(&ingresses[i]))
+	}
+	return result
+}
+
+func toMultiClusterIngress(mci *networkingv1alpha1.MultiClusterIngress) MultiClusterIngress {
+	hosts := make([]string, 0, len(mci.Spec.Rules))
+	for _, rule := range mci.Spec.Rules {
+		if rule.Host != "" {
+			hosts = append(hosts, rule.Host)
+		}
+	}
+	return MultiClusterIngress{
+		ObjectMeta: types.NewObjectMeta(mci.ObjectMeta),
+		TypeMeta:   types.NewTypeMeta(types.ResourceKindMultiClusterIngress),
+		Hosts:      hosts,
+	}
+}
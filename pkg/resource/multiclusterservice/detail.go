@@ -0,0 +1,216 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiclusterservice
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	networkingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/networking/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/names"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	mcsv1alpha1 "sigs.k8s.io/mcs-api/pkg/apis/v1alpha1"
+
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
+)
+
+// MultiClusterServiceDetail is a presentation layer view of Karmada MultiClusterService resource.
+type MultiClusterServiceDetail struct {
+	// Extends list item structure.
+	MultiClusterService `json:",inline"`
+
+	Status v1.ServiceStatus `json:"status"`
+	// MemberStates is the service discovery state of the service in every related member cluster.
+	MemberStates []MemberServiceState `json:"memberStates"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// MemberServiceState is the service discovery state of a multi-cluster service in a member cluster.
+type MemberServiceState struct {
+	Cluster  string `json:"cluster"`
+	Provider bool   `json:"provider"`
+	Consumer bool   `json:"consumer"`
+	// ServiceExport is the ServiceExport of the service in the cluster, nil if not exported.
+	ServiceExport *mcsv1alpha1.ServiceExport `json:"serviceExport,omitempty"`
+	// ServiceImport is the ServiceImport of the service in the cluster, nil if not imported.
+	ServiceImport  *mcsv1alpha1.ServiceImport `json:"serviceImport,omitempty"`
+	EndpointSlices []EndpointSliceState       `json:"endpointSlices"`
+	// Error is the error occurred when collecting the state from the cluster.
+	Error string `json:"error,omitempty"`
+}
+
+// EndpointSliceState summarizes an EndpointSlice of the service in a member cluster.
+type EndpointSliceState struct {
+	Name        string                     `json:"name"`
+	AddressType discoveryv1.AddressType    `json:"addressType"`
+	Ports       []discoveryv1.EndpointPort `json:"ports"`
+	Ready       int                        `json:"ready"`
+	NotReady    int                        `json:"notReady"`
+	// ManagedBy tells which controller manages the slice, slices collected by karmada from
+	// provider clusters are managed by karmada.
+	ManagedBy string `json:"managedBy"`
+	// ProvisionCluster is the cluster the endpoints come from, only set for slices dispatched by karmada.
+	ProvisionCluster string `json:"provisionCluster,omitempty"`
+}
+
+// GetMultiClusterServiceDetail gets MultiClusterService details, including the service discovery state
+// collected from provider and consumer clusters.
+func GetMultiClusterServiceDetail(karmadaClient karmadaclientset.Interface, namespace, name string) (*MultiClusterServiceDetail, error) {
+	mcs, err := karmadaClient.NetworkingV1alpha1().MultiClusterServices(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	clusters, err := karmadaClient.ClusterV1alpha1().Clusters().List(context.TODO(), helpers.ListEverything)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MultiClusterServiceDetail{
+		MultiClusterService: toMultiClusterService(mcs),
+		Status:              mcs.Status,
+		MemberStates:        getMemberStates(mcs, clusters.Items, client.InClusterRuntimeClientForMemberCluster),
+		Errors:              []error{},
+	}, nil
+}
+
+// relatedClusters returns the provider and consumer clusters of the service, an empty selector list means all clusters.
+func relatedClusters(mcs *networkingv1alpha1.MultiClusterService, clusters []clusterv1alpha1.Cluster) (providers, consumers map[string]bool) {
+	selected := func(selectors []networkingv1alpha1.ClusterSelector) map[string]bool {
+		result := make(map[string]bool)
+		for _, selector := range selectors {
+			result[selector.Name] = true
+		}
+		if len(result) == 0 {
+			for i := range clusters {
+				result[clusters[i].Name] = true
+			}
+		}
+		return result
+	}
+	return selected(mcs.Spec.ProviderClusters), selected(mcs.Spec.ConsumerClusters)
+}
+
+// getMemberStates collects the state of the service from every related cluster with the client returned
+// by memberClient, which is called concurrently.
+func getMemberStates(mcs *networkingv1alpha1.MultiClusterService, clusters []clusterv1alpha1.Cluster,
+	memberClient func(cluster string) ctrlclient.Client) []MemberServiceState {
+	providers, consumers := relatedClusters(mcs, clusters)
+	ready := make(map[string]bool)
+	for i := range clusters {
+		ready[clusters[i].Name] = util.IsClusterReady(&clusters[i].Status)
+	}
+	states := make([]MemberServiceState, 0)
+	for i := range clusters {
+		name := clusters[i].Name
+		if providers[name] || consumers[name] {
+			states = append(states, MemberServiceState{Cluster: name, Provider: providers[name], Consumer: consumers[name]})
+		}
+	}
+
+	var wg sync.WaitGroup
+	for i := range states {
+		state := &states[i]
+		if !ready[state.Cluster] {
+			state.Error = "cluster is not ready"
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := collectMemberState(memberClient(state.Cluster), mcs.Namespace, mcs.Name, state); err != nil {
+				state.Error = err.Error()
+			}
+		}()
+	}
+	wg.Wait()
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].Cluster < states[j].Cluster
+	})
+	return states
+}
+
+func collectMemberState(memberClient ctrlclient.Client, namespace, name string, state *MemberServiceState) error {
+	ctx := context.TODO()
+	if memberClient == nil {
+		return k8serrors.NewServiceUnavailable("failed to init client for member cluster")
+	}
+	key := types.NamespacedName{Namespace: namespace, Name: name}
+
+	// mcs-api CRDs may not be installed in clusters which don't take part in service discovery.
+	serviceExport := &mcsv1alpha1.ServiceExport{}
+	if err := memberClient.Get(ctx, key, serviceExport); err == nil {
+		state.ServiceExport = serviceExport
+	} else if !k8serrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+		return err
+	}
+	serviceImport := &mcsv1alpha1.ServiceImport{}
+	if err := memberClient.Get(ctx, key, serviceImport); err == nil {
+		state.ServiceImport = serviceImport
+	} else if !k8serrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+		return err
+	}
+
+	// slices of the service itself and of the derived service created for ServiceImport.
+	requirement, err := labels.NewRequirement(discoveryv1.LabelServiceName, selection.In,
+		[]string{name, names.GenerateDerivedServiceName(name)})
+	if err != nil {
+		return err
+	}
+	slices := &discoveryv1.EndpointSliceList{}
+	if err = memberClient.List(ctx, slices, ctrlclient.InNamespace(namespace),
+		ctrlclient.MatchingLabelsSelector{Selector: labels.NewSelector().Add(*requirement)}); err != nil {
+		return err
+	}
+	state.EndpointSlices = make([]EndpointSliceState, 0, len(slices.Items))
+	for i := range slices.Items {
+		state.EndpointSlices = append(state.EndpointSlices, toEndpointSliceState(&slices.Items[i]))
+	}
+	return nil
+}
+
+func toEndpointSliceState(slice *discoveryv1.EndpointSlice) EndpointSliceState {
+	state := EndpointSliceState{
+		Name:             slice.Name,
+		AddressType:      slice.AddressType,
+		Ports:            slice.Ports,
+		ManagedBy:        slice.Labels[discoveryv1.LabelManagedBy],
+		ProvisionCluster: slice.Annotations[util.EndpointSliceProvisionClusterAnnotation],
+	}
+	for _, endpoint := range slice.Endpoints {
+		if endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready {
+			state.Ready++
+		} else {
+			state.NotReady++
+		}
+	}
+	return state
+}
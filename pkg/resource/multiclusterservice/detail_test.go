@@ -0,0 +1,190 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiclusterservice
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	networkingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/networking/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/gclient"
+	"github.com/karmada-io/karmada/pkg/util/names"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	mcsv1alpha1 "sigs.k8s.io/mcs-api/pkg/apis/v1alpha1"
+)
+
+func newCluster(name string, ready bool) clusterv1alpha1.Cluster {
+	status := metaV1.ConditionFalse
+	if ready {
+		status = metaV1.ConditionTrue
+	}
+	return clusterv1alpha1.Cluster{
+		ObjectMeta: metaV1.ObjectMeta{Name: name},
+		Status: clusterv1alpha1.ClusterStatus{
+			Conditions: []metaV1.Condition{{Type: clusterv1alpha1.ClusterConditionReady, Status: status}},
+		},
+	}
+}
+
+func TestRelatedClusters(t *testing.T) {
+	clusters := []clusterv1alpha1.Cluster{newCluster("member1", true), newCluster("member2", true), newCluster("member3", true)}
+	all := map[string]bool{"member1": true, "member2": true, "member3": true}
+	cases := []struct {
+		name          string
+		providers     []string
+		consumers     []string
+		wantProviders map[string]bool
+		wantConsumers map[string]bool
+	}{
+		{
+			name:          "empty selectors select all clusters",
+			wantProviders: all,
+			wantConsumers: all,
+		},
+		{
+			name:          "providers set",
+			providers:     []string{"member1"},
+			wantProviders: map[string]bool{"member1": true},
+			wantConsumers: all,
+		},
+		{
+			name:          "providers and consumers set",
+			providers:     []string{"member1"},
+			consumers:     []string{"member2", "member3"},
+			wantProviders: map[string]bool{"member1": true},
+			wantConsumers: map[string]bool{"member2": true, "member3": true},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mcs := &networkingv1alpha1.MultiClusterService{}
+			for _, name := range tc.providers {
+				mcs.Spec.ProviderClusters = append(mcs.Spec.ProviderClusters, networkingv1alpha1.ClusterSelector{Name: name})
+			}
+			for _, name := range tc.consumers {
+				mcs.Spec.ConsumerClusters = append(mcs.Spec.ConsumerClusters, networkingv1alpha1.ClusterSelector{Name: name})
+			}
+			providers, consumers := relatedClusters(mcs, clusters)
+			if !reflect.DeepEqual(providers, tc.wantProviders) {
+				t.Errorf("relatedClusters() providers = %v, want %v", providers, tc.wantProviders)
+			}
+			if !reflect.DeepEqual(consumers, tc.wantConsumers) {
+				t.Errorf("relatedClusters() consumers = %v, want %v", consumers, tc.wantConsumers)
+			}
+		})
+	}
+}
+
+func TestToEndpointSliceState(t *testing.T) {
+	slice := &discoveryv1.EndpointSlice{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:        "web-member1-abcde",
+			Labels:      map[string]string{discoveryv1.LabelManagedBy: util.EndpointSliceDispatchControllerLabelValue},
+			Annotations: map[string]string{util.EndpointSliceProvisionClusterAnnotation: "member1"},
+		},
+		AddressType: discoveryv1.AddressTypeIPv4,
+		Endpoints: []discoveryv1.Endpoint{
+			// an endpoint without ready condition is ready
+			{Addresses: []string{"10.0.0.1"}},
+			{Addresses: []string{"10.0.0.2"}, Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(true)}},
+			{Addresses: []string{"10.0.0.3"}, Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(false)}},
+		},
+	}
+	want := EndpointSliceState{
+		Name:             "web-member1-abcde",
+		AddressType:      discoveryv1.AddressTypeIPv4,
+		Ready:            2,
+		NotReady:         1,
+		ManagedBy:        util.EndpointSliceDispatchControllerLabelValue,
+		ProvisionCluster: "member1",
+	}
+	if got := toEndpointSliceState(slice); !reflect.DeepEqual(got, want) {
+		t.Errorf("toEndpointSliceState() = %+v, want %+v", got, want)
+	}
+}
+
+func TestGetMemberStates(t *testing.T) {
+	mcs := &networkingv1alpha1.MultiClusterService{
+		ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "web"},
+		Spec: networkingv1alpha1.MultiClusterServiceSpec{
+			ProviderClusters: []networkingv1alpha1.ClusterSelector{{Name: "member1"}},
+			ConsumerClusters: []networkingv1alpha1.ClusterSelector{{Name: "member2"}, {Name: "member3"}, {Name: "member4"}},
+		},
+	}
+	clusters := []clusterv1alpha1.Cluster{
+		newCluster("member1", true), newCluster("member2", true), newCluster("member3", false),
+		newCluster("member4", true), newCluster("member5", true),
+	}
+	slice := func(name, service string) *discoveryv1.EndpointSlice {
+		return &discoveryv1.EndpointSlice{
+			ObjectMeta:  metaV1.ObjectMeta{Namespace: "default", Name: name, Labels: map[string]string{discoveryv1.LabelServiceName: service}},
+			AddressType: discoveryv1.AddressTypeIPv4,
+			Endpoints:   []discoveryv1.Endpoint{{Addresses: []string{"10.0.0.1"}}},
+		}
+	}
+	provider := fake.NewClientBuilder().WithScheme(gclient.NewSchema()).WithObjects(
+		&mcsv1alpha1.ServiceExport{ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "web"}},
+		slice("web-abcde", "web"),
+		slice("api-abcde", "api"),
+	).Build()
+	// the mcs-api CRDs are not installed in the consumer cluster
+	consumer := fake.NewClientBuilder().WithScheme(gclient.NewSchema()).WithObjects(
+		slice("derived-web-abcde", names.GenerateDerivedServiceName("web")),
+	).WithInterceptorFuncs(interceptor.Funcs{
+		Get: func(ctx context.Context, c ctrlclient.WithWatch, key ctrlclient.ObjectKey, obj ctrlclient.Object, opts ...ctrlclient.GetOption) error {
+			return &meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: mcsv1alpha1.GroupName, Kind: "ServiceExport"}}
+		},
+	}).Build()
+	memberClients := map[string]ctrlclient.Client{"member1": provider, "member2": consumer}
+
+	states := getMemberStates(mcs, clusters, func(cluster string) ctrlclient.Client {
+		if c, ok := memberClients[cluster]; ok {
+			return c
+		}
+		return nil
+	})
+
+	if len(states) != 4 {
+		t.Fatalf("getMemberStates() = %+v, want the states of member1 to member4", states)
+	}
+	member1, member2, member3, member4 := states[0], states[1], states[2], states[3]
+	if member1.Cluster != "member1" || !member1.Provider || member1.Consumer || member1.Error != "" ||
+		member1.ServiceExport == nil || member1.ServiceImport != nil ||
+		len(member1.EndpointSlices) != 1 || member1.EndpointSlices[0].Name != "web-abcde" {
+		t.Errorf("getMemberStates() member1 = %+v, want the exported service of the provider", member1)
+	}
+	if member2.Cluster != "member2" || member2.Provider || !member2.Consumer || member2.Error != "" ||
+		member2.ServiceExport != nil || len(member2.EndpointSlices) != 1 || member2.EndpointSlices[0].Name != "derived-web-abcde" {
+		t.Errorf("getMemberStates() member2 = %+v, want the derived service slices without mcs-api", member2)
+	}
+	if member3.Cluster != "member3" || member3.Error != "cluster is not ready" {
+		t.Errorf("getMemberStates() member3 = %+v, want the not ready cluster reported", member3)
+	}
+	if member4.Cluster != "member4" || member4.Error == "" {
+		t.Errorf("getMemberStates() member4 = %+v, want the failure to build its client reported", member4)
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiclusterservice
+
+import (
+	"context"
+
+	networkingv1alpha1 "github.com/karmada-io/karmada/pkg/apis/networking/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+)
+
+// MultiClusterServiceList contains a list of MultiClusterServices in the karmada control-plane.
+type MultiClusterServiceList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+
+	// Unordered list of MultiClusterServices.
+	Items []MultiClusterService `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// MultiClusterService contains information about a single MultiClusterService.
+type MultiClusterService struct {
+	ObjectMeta types.ObjectMeta                  `json:"objectMeta"`
+	TypeMeta   types.TypeMeta                    `json:"typeMeta"`
+	Types      []networkingv1alpha1.ExposureType `json:"types"`
+	Ports      []networkingv1alpha1.ExposurePort `json:"ports"`
+	// ProviderClusters are the clusters providing the service, empty means all clusters.
+	ProviderClusters []string `json:"providerClusters"`
+	// ConsumerClusters are the clusters consuming the service, empty means all clusters.
+	ConsumerClusters []string `json:"consumerClusters"`
+}
+
+// GetMultiClusterServiceList returns a list of all MultiClusterServices in the karmada control-plane.
+func GetMultiClusterServiceList(client karmadaclientset.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*MultiClusterServiceList, error) {
+	mcsList, err := client.NetworkingV1alpha1().MultiClusterServices(nsQuery.ToRequestParam()).List(context.TODO(), helpers.ListEverything)
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	return toMultiClusterServiceList(mcsList.Items, nonCriticalErrors, dsQuery), nil
+}
+
+func toMultiClusterServiceList(services []networkingv1alpha1.MultiClusterService, nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery) *MultiClusterServiceList {
+	result := &MultiClusterServiceList{
+		Items:    make([]MultiClusterService, 0),
+		ListMeta: types.ListMeta{TotalItems: len(services)},
+		Errors:   nonCriticalErrors,
+	}
+
+	serviceCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(services), dsQuery)
+	services = fromCells(serviceCells)
+	result.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+
+	for i := range services {
+		result.Items = append(result.Items, toMultiClusterService(&services[i]))
+	}
+	return result
+}
+
+func toMultiClusterService(mcs *networkingv1alpha1.MultiClusterService) MultiClusterService {
+	return MultiClusterService{
+		ObjectMeta:       types.NewObjectMeta(mcs.ObjectMeta),
+		TypeMeta:         types.NewTypeMeta(types.ResourceKindMultiClusterService),
+		Types:            mcs.Spec.Types,
+		Ports:            mcs.Spec.Ports,
+		ProviderClusters: clusterNames(mcs.Spec.ProviderClusters),
+		ConsumerClusters: clusterNames(mcs.Spec.ConsumerClusters),
+	}
+}
+
+func clusterNames(selectors []networkingv1alpha1.ClusterSelector) []string {
+	names := make([]string, 0, len(selectors))
+	for _, selector := range selectors {
+		names = append(names, selector.Name)
+	}
+	return names
+}
@@ -23,6 +23,8 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sClient "k8s.io/client-go/kubernetes"
+
+	"github.com/karmada-io/dashboard/pkg/resource/federatedresourcequota"
 )
 
 // NamespaceDetail is a presentation layer view of Kubernetes Namespace resource. This means it is Namespace plus
@@ -31,6 +33,10 @@ type NamespaceDetail struct {
 	// Extends list item structure.
 	Namespace `json:",inline"`
 
+	// QuotaUsage is the usage of federated resource quotas in the namespace, it's only available
+	// for namespaces in karmada control-plane.
+	QuotaUsage []federatedresourcequota.FederatedResourceQuota `json:"quotaUsage,omitempty"`
+
 	// List of non-critical errors, that occurred during resource retrieval.
 	Errors []error `json:"errors"`
 }
@@ -0,0 +1,220 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+	resourcehelper "k8s.io/kubectl/pkg/util/resource"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+	"github.com/karmada-io/dashboard/pkg/resource/event"
+)
+
+// NodeAllocatedResources describes node allocated resources.
+type NodeAllocatedResources struct {
+	// CPURequests is number of allocated milicores.
+	CPURequests int64 `json:"cpuRequests"`
+
+	// CPURequestsFraction is a fraction of CPU, that is allocated.
+	CPURequestsFraction float64 `json:"cpuRequestsFraction"`
+
+	// CPULimits is defined CPU limit.
+	CPULimits int64 `json:"cpuLimits"`
+
+	// CPULimitsFraction is a fraction of defined CPU limit, can be over 100%, i.e.
+	// overcommitted.
+	CPULimitsFraction float64 `json:"cpuLimitsFraction"`
+
+	// CPUCapacity is specified node CPU capacity in milicores.
+	CPUCapacity int64 `json:"cpuCapacity"`
+
+	// MemoryRequests is a fraction of memory, that is allocated.
+	MemoryRequests int64 `json:"memoryRequests"`
+
+	// MemoryRequestsFraction is a fraction of memory, that is allocated.
+	MemoryRequestsFraction float64 `json:"memoryRequestsFraction"`
+
+	// MemoryLimits is defined memory limit.
+	MemoryLimits int64 `json:"memoryLimits"`
+
+	// MemoryLimitsFraction is a fraction of defined memory limit, can be over 100%, i.e.
+	// overcommitted.
+	MemoryLimitsFraction float64 `json:"memoryLimitsFraction"`
+
+	// MemoryCapacity is specified node memory capacity in bytes.
+	MemoryCapacity int64 `json:"memoryCapacity"`
+
+	// AllocatedPods in number of currently allocated pods on the node.
+	AllocatedPods int `json:"allocatedPods"`
+
+	// PodCapacity is maximum number of pods, that can be allocated on the node.
+	PodCapacity int64 `json:"podCapacity"`
+
+	// PodFraction is a fraction of pods, that can be allocated on given node.
+	PodFraction float64 `json:"podFraction"`
+}
+
+// NodeDetail is a presentation layer view of Kubernetes Node resource.
+type NodeDetail struct {
+	// Extends list item structure.
+	Node `json:",inline"`
+
+	// PodCIDR represents the pod IP range assigned to the node.
+	PodCIDR string `json:"podCIDR"`
+
+	// ID of the node assigned by the cloud provider.
+	ProviderID string `json:"providerID"`
+
+	// Unschedulable controls node schedulability of new pods. By default node is schedulable.
+	Unschedulable bool `json:"unschedulable"`
+
+	// Taints of the node.
+	Taints []v1.Taint `json:"taints"`
+
+	// Capacity is the total resources of the node, Allocatable is the part of it available for pods.
+	Capacity    v1.ResourceList `json:"capacity"`
+	Allocatable v1.ResourceList `json:"allocatable"`
+
+	// AllocatedResources are the requests and limits of the pods running on the node.
+	AllocatedResources NodeAllocatedResources `json:"allocatedResources"`
+
+	// Images is the list of container images on the node.
+	Images []v1.ContainerImage `json:"images"`
+
+	// Events is list of events associated with the node.
+	EventList common.EventList `json:"eventList"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetNodeDetail gets node details.
+func GetNodeDetail(client kubernetes.Interface, name string) (*NodeDetail, error) {
+	node, err := client.CoreV1().Nodes().Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := getNodePods(client, node.Name)
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	events, err := getNodeEvents(client, node.Name)
+	nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	return &NodeDetail{
+		Node:               toNode(node.ObjectMeta, node.Status),
+		PodCIDR:            node.Spec.PodCIDR,
+		ProviderID:         node.Spec.ProviderID,
+		Unschedulable:      node.Spec.Unschedulable,
+		Taints:             node.Spec.Taints,
+		Capacity:           node.Status.Capacity,
+		Allocatable:        node.Status.Allocatable,
+		AllocatedResources: getNodeAllocatedResources(node, pods),
+		Images:             node.Status.Images,
+		EventList:          event.CreateEventList(event.FillEventsType(events), dataselect.NoDataSelect),
+		Errors:             nonCriticalErrors,
+	}, nil
+}
+
+// getNodePods returns the pods on the node that hold resources, pods in terminal phases are skipped.
+func getNodePods(client kubernetes.Interface, nodeName string) ([]v1.Pod, error) {
+	fieldSelector, err := fields.ParseSelector("spec.nodeName=" + nodeName +
+		",status.phase!=" + string(v1.PodSucceeded) +
+		",status.phase!=" + string(v1.PodFailed))
+	if err != nil {
+		return nil, err
+	}
+	pods, err := client.CoreV1().Pods(metav1.NamespaceAll).List(context.TODO(), metav1.ListOptions{
+		FieldSelector: fieldSelector.String(),
+	})
+	if err != nil {
+		return []v1.Pod{}, err
+	}
+	return pods.Items, nil
+}
+
+func getNodeEvents(client kubernetes.Interface, nodeName string) ([]v1.Event, error) {
+	selector := fields.Set{"involvedObject.kind": "Node", "involvedObject.name": nodeName}.AsSelector()
+	events, err := client.CoreV1().Events(metav1.NamespaceAll).List(context.TODO(), metav1.ListOptions{
+		FieldSelector: selector.String(),
+	})
+	if err != nil {
+		return []v1.Event{}, err
+	}
+	return events.Items, nil
+}
+
+func getNodeAllocatedResources(node *v1.Node, pods []v1.Pod) NodeAllocatedResources {
+	reqs, limits := map[v1.ResourceName]resource.Quantity{}, map[v1.ResourceName]resource.Quantity{}
+	for i := range pods {
+		podReqs, podLimits := resourcehelper.PodRequestsAndLimits(&pods[i])
+		for name, quantity := range podReqs {
+			value := reqs[name]
+			value.Add(quantity)
+			reqs[name] = value
+		}
+		for name, quantity := range podLimits {
+			value := limits[name]
+			value.Add(quantity)
+			limits[name] = value
+		}
+	}
+
+	cpuRequests, cpuLimits, memoryRequests, memoryLimits := reqs[v1.ResourceCPU], limits[v1.ResourceCPU],
+		reqs[v1.ResourceMemory], limits[v1.ResourceMemory]
+
+	allocatable := node.Status.Capacity
+	if len(node.Status.Allocatable) > 0 {
+		allocatable = node.Status.Allocatable
+	}
+	result := NodeAllocatedResources{
+		CPURequests:    cpuRequests.MilliValue(),
+		CPULimits:      cpuLimits.MilliValue(),
+		CPUCapacity:    allocatable.Cpu().MilliValue(),
+		MemoryRequests: memoryRequests.Value(),
+		MemoryLimits:   memoryLimits.Value(),
+		MemoryCapacity: allocatable.Memory().Value(),
+		AllocatedPods:  len(pods),
+		PodCapacity:    allocatable.Pods().Value(),
+	}
+	if result.CPUCapacity > 0 {
+		result.CPURequestsFraction = float64(result.CPURequests) / float64(result.CPUCapacity) * 100
+		result.CPULimitsFraction = float64(result.CPULimits) / float64(result.CPUCapacity) * 100
+	}
+	if result.MemoryCapacity > 0 {
+		result.MemoryRequestsFraction = float64(result.MemoryRequests) / float64(result.MemoryCapacity) * 100
+		result.MemoryLimitsFraction = float64(result.MemoryLimits) / float64(result.MemoryCapacity) * 100
+	}
+	if result.PodCapacity > 0 {
+		result.PodFraction = float64(result.AllocatedPods) / float64(result.PodCapacity) * 100
+	}
+	return result
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"context"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/kubectl/pkg/drain"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+)
+
+// defaultDrainTimeout bounds the eviction of the pods so that a drain blocked by a PodDisruptionBudget
+// does not hang the request forever.
+const defaultDrainTimeout = 2 * time.Minute
+
+// DrainPodStatus is the eviction status of a pod while draining a node.
+type DrainPodStatus string
+
+const (
+	// DrainPodPending means the pod is going to be evicted.
+	DrainPodPending DrainPodStatus = "Pending"
+	// DrainPodEvicted means the pod has been evicted and is gone from the node.
+	DrainPodEvicted DrainPodStatus = "Evicted"
+	// DrainPodFailed means the pod could not be evicted, usually because of a PodDisruptionBudget.
+	DrainPodFailed DrainPodStatus = "Failed"
+)
+
+// DrainOptions controls how the pods of a node are evicted, it mirrors the flags of kubectl drain.
+type DrainOptions struct {
+	// Force evicts pods that are not managed by a controller.
+	Force bool
+	// IgnoreDaemonSets skips the pods managed by a DaemonSet instead of refusing to drain.
+	IgnoreDaemonSets bool
+	// DeleteEmptyDirData evicts pods using emptyDir volumes, the data is lost.
+	DeleteEmptyDirData bool
+	// GracePeriodSeconds overrides the termination grace period of the pods, negative means the pod default.
+	GracePeriodSeconds int
+	// Timeout is how long to wait for the pods to be evicted, defaults to two minutes.
+	Timeout time.Duration
+	// DryRun only reports the pods that would be evicted and the PodDisruptionBudgets blocking them.
+	DryRun bool
+}
+
+// DrainPod is a pod evicted by draining a node.
+type DrainPod struct {
+	Namespace string         `json:"namespace"`
+	Name      string         `json:"name"`
+	Status    DrainPodStatus `json:"status"`
+	Error     string         `json:"error,omitempty"`
+	// BlockingPDBs are the PodDisruptionBudgets covering the pod that currently allow no disruption.
+	BlockingPDBs []string `json:"blockingPDBs,omitempty"`
+}
+
+// NodeDrainResult is the outcome of draining a node.
+type NodeDrainResult struct {
+	Node   string     `json:"node"`
+	DryRun bool       `json:"dryRun"`
+	Pods   []DrainPod `json:"pods"`
+	// Warnings lists the pods skipped or evicted despite a warning, such as DaemonSet managed pods.
+	Warnings string `json:"warnings,omitempty"`
+	// Errors is set when some pods could not be evicted before the timeout, the node stays cordoned.
+	Errors []string `json:"errors"`
+}
+
+// CordonNode marks the node as unschedulable, or schedulable again when cordon is false.
+func CordonNode(client kubernetes.Interface, name string, cordon bool) error {
+	node, err := client.CoreV1().Nodes().Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	return drain.RunCordonOrUncordon(newDrainHelper(context.TODO(), client, DrainOptions{}), node, cordon)
+}
+
+// DrainNode cordons the node and evicts its pods through the eviction API, so PodDisruptionBudgets are
+// honoured: an eviction denied by a budget is retried until the timeout.
+func DrainNode(client kubernetes.Interface, name string, opts DrainOptions) (*NodeDrainResult, error) {
+	if opts.Timeout <= 0 {
+		opts.Timeout = defaultDrainTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.TODO(), opts.Timeout)
+	defer cancel()
+
+	node, err := client.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	helper := newDrainHelper(ctx, client, opts)
+	list, errs := helper.GetPodsForDeletion(node.Name)
+	if errs != nil {
+		return nil, errors.NewBadRequest(utilerrors.NewAggregate(errs).Error())
+	}
+
+	pods := list.Pods()
+	result := &NodeDrainResult{
+		Node:     node.Name,
+		DryRun:   opts.DryRun,
+		Pods:     make([]DrainPod, 0, len(pods)),
+		Warnings: list.Warnings(),
+		Errors:   []string{},
+	}
+	budgets := map[string][]policyv1.PodDisruptionBudget{}
+	for i := range pods {
+		pod := &pods[i]
+		if _, ok := budgets[pod.Namespace]; !ok {
+			pdbs, err := client.PolicyV1().PodDisruptionBudgets(pod.Namespace).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return nil, err
+			}
+			budgets[pod.Namespace] = pdbs.Items
+		}
+		result.Pods = append(result.Pods, DrainPod{
+			Namespace:    pod.Namespace,
+			Name:         pod.Name,
+			Status:       DrainPodPending,
+			BlockingPDBs: blockingPDBs(pod, budgets[pod.Namespace]),
+		})
+	}
+	if opts.DryRun {
+		return result, nil
+	}
+
+	if err := drain.RunCordonOrUncordon(helper, node, true); err != nil {
+		return nil, err
+	}
+
+	index := make(map[string]int, len(result.Pods))
+	for i, pod := range result.Pods {
+		index[pod.Namespace+"/"+pod.Name] = i
+	}
+	var lock sync.Mutex
+	helper.OnPodDeletionOrEvictionFinished = func(pod *corev1.Pod, _ bool, err error) {
+		lock.Lock()
+		defer lock.Unlock()
+		i, ok := index[pod.Namespace+"/"+pod.Name]
+		if !ok {
+			return
+		}
+		if err != nil {
+			result.Pods[i].Status, result.Pods[i].Error = DrainPodFailed, err.Error()
+			return
+		}
+		result.Pods[i].Status, result.Pods[i].BlockingPDBs = DrainPodEvicted, nil
+	}
+	if err := helper.DeleteOrEvictPods(pods); err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+	return result, nil
+}
+
+func newDrainHelper(ctx context.Context, client kubernetes.Interface, opts DrainOptions) *drain.Helper {
+	return &drain.Helper{
+		Ctx:                 ctx,
+		Client:              client,
+		Force:               opts.Force,
+		IgnoreAllDaemonSets: opts.IgnoreDaemonSets,
+		DeleteEmptyDirData:  opts.DeleteEmptyDirData,
+		GracePeriodSeconds:  opts.GracePeriodSeconds,
+		Timeout:             opts.Timeout,
+		Out:                 io.Discard,
+		ErrOut:              io.Discard,
+	}
+}
+
+// blockingPDBs returns the names of the budgets selecting the pod that allow no more disruption.
+func blockingPDBs(pod *corev1.Pod, pdbs []policyv1.PodDisruptionBudget) []string {
+	var names []string
+	for _, pdb := range pdbs {
+		if pdb.Namespace != pod.Namespace || pdb.Status.DisruptionsAllowed > 0 {
+			continue
+		}
+		// in policy/v1 a nil selector matches no pod and an empty one every pod of the namespace
+		selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil {
+			continue
+		}
+		if selector.Matches(labels.Set(pod.Labels)) {
+			names = append(names, pdb.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
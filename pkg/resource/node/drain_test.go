@@ -0,0 +1,79 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newPDB(namespace, name string, selector *metav1.LabelSelector, allowed int32) policyv1.PodDisruptionBudget {
+	return policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec:       policyv1.PodDisruptionBudgetSpec{Selector: selector},
+		Status:     policyv1.PodDisruptionBudgetStatus{DisruptionsAllowed: allowed},
+	}
+}
+
+func TestBlockingPDBs(t *testing.T) {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nginx-0", Labels: map[string]string{"app": "nginx"}}}
+	matchNginx := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "nginx"}}
+	cases := []struct {
+		name string
+		pdbs []policyv1.PodDisruptionBudget
+		want []string
+	}{
+		{
+			name: "no budget",
+			want: nil,
+		},
+		{
+			name: "budget allowing disruption",
+			pdbs: []policyv1.PodDisruptionBudget{newPDB("default", "nginx", matchNginx, 1)},
+			want: nil,
+		},
+		{
+			name: "budget exhausted",
+			pdbs: []policyv1.PodDisruptionBudget{
+				newPDB("default", "nginx", matchNginx, 0),
+				newPDB("default", "all", &metav1.LabelSelector{}, 0),
+			},
+			want: []string{"all", "nginx"},
+		},
+		{
+			name: "budget not selecting the pod",
+			pdbs: []policyv1.PodDisruptionBudget{
+				newPDB("default", "redis", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "redis"}}, 0),
+				newPDB("default", "none", nil, 0),
+				newPDB("other", "nginx", matchNginx, 0),
+			},
+			want: nil,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := blockingPDBs(pod, tc.pdbs)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("blockingPDBs() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
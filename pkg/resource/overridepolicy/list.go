@@ -49,6 +49,9 @@ type OverridePolicy struct {
 	// Override specificed data
 	ResourceSelectors []v1alpha1.ResourceSelector `json:"resourceSelectors"`
 	OverrideRules     []v1alpha1.RuleWithCluster  `json:"overrideRules"`
+	// Warnings are the problems of the policy reported by policy analyzer, only set when the list is
+	// requested with analyze=true.
+	Warnings []string `json:"warnings"`
 }
 
 // GetOverridePolicyList returns a list of all override policies in the Karmada control-plane.
@@ -0,0 +1,89 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package overridepolicy
+
+import (
+	"encoding/json"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/karmada-io/karmada/pkg/util/overridemanager"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// OverridePreview is the effective manifest of a resource template in a member cluster, it's the result of
+// applying all matching ClusterOverridePolicies and OverridePolicies in the same order as karmada does.
+type OverridePreview struct {
+	Cluster string `json:"cluster"`
+
+	// Template is the resource template in the karmada control-plane.
+	Template *unstructured.Unstructured `json:"template"`
+
+	// Manifest is the resource that will be applied to the member cluster.
+	Manifest *unstructured.Unstructured `json:"manifest"`
+
+	// AppliedClusterOverrides lists the ClusterOverridePolicies applied to the template.
+	AppliedClusterOverrides []overridemanager.OverridePolicyShadow `json:"appliedClusterOverrides"`
+
+	// AppliedOverrides lists the OverridePolicies applied to the template.
+	AppliedOverrides []overridemanager.OverridePolicyShadow `json:"appliedOverrides"`
+
+	// Diff is the JSON merge patch from the template to the effective manifest.
+	Diff json.RawMessage `json:"diff"`
+}
+
+// GetOverridePreview applies the override policies matching the given resource template for the cluster
+// and returns the effective manifest, the template is left untouched.
+func GetOverridePreview(c client.Client, template *unstructured.Unstructured, clusterName string) (*OverridePreview, error) {
+	manifest := template.DeepCopy()
+	// events should not be recorded for a preview, a FakeRecorder without channel drops them.
+	overrideManager := overridemanager.New(c, &record.FakeRecorder{})
+	appliedClusterOverrides, appliedOverrides, err := overrideManager.ApplyOverridePolicies(manifest, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	templateData, err := template.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	manifestData, err := manifest.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	diff, err := jsonpatch.CreateMergePatch(templateData, manifestData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OverridePreview{
+		Cluster:                 clusterName,
+		Template:                template,
+		Manifest:                manifest,
+		AppliedClusterOverrides: toAppliedItems(appliedClusterOverrides),
+		AppliedOverrides:        toAppliedItems(appliedOverrides),
+		Diff:                    diff,
+	}, nil
+}
+
+func toAppliedItems(appliedOverrides *overridemanager.AppliedOverrides) []overridemanager.OverridePolicyShadow {
+	if appliedOverrides == nil {
+		return make([]overridemanager.OverridePolicyShadow, 0)
+	}
+	return appliedOverrides.AppliedItems
+}
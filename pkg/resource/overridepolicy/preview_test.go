@@ -0,0 +1,133 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package overridepolicy
+
+import (
+	"testing"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/util/gclient"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestGetOverridePreview(t *testing.T) {
+	overridePolicy := &policyv1alpha1.OverridePolicy{
+		ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "nginx"},
+		Spec: policyv1alpha1.OverrideSpec{
+			ResourceSelectors: []policyv1alpha1.ResourceSelector{{APIVersion: "apps/v1", Kind: "Deployment", Name: "nginx"}},
+			OverrideRules: []policyv1alpha1.RuleWithCluster{
+				{
+					TargetCluster: &policyv1alpha1.ClusterAffinity{ClusterNames: []string{"member1"}},
+					Overriders: policyv1alpha1.Overriders{
+						Plaintext: []policyv1alpha1.PlaintextOverrider{
+							{Path: "/spec/replicas", Operator: policyv1alpha1.OverriderOpReplace, Value: apiextensionsv1.JSON{Raw: []byte("3")}},
+						},
+					},
+				},
+				{
+					TargetCluster: &policyv1alpha1.ClusterAffinity{ClusterNames: []string{"member2"}},
+					Overriders: policyv1alpha1.Overriders{
+						ImageOverrider: []policyv1alpha1.ImageOverrider{
+							{Component: policyv1alpha1.Registry, Operator: policyv1alpha1.OverriderOpAdd, Value: "registry.example.io"},
+						},
+					},
+				},
+			},
+		},
+	}
+	objects := []client.Object{overridePolicy}
+	for _, name := range []string{"member1", "member2", "member3"} {
+		objects = append(objects, &clusterv1alpha1.Cluster{ObjectMeta: metaV1.ObjectMeta{Name: name}})
+	}
+	c := fake.NewClientBuilder().WithScheme(gclient.NewSchema()).WithObjects(objects...).Build()
+	template := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"namespace": "default", "name": "nginx"},
+		"spec": map[string]interface{}{
+			"replicas": int64(1),
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{map[string]interface{}{"name": "nginx", "image": "nginx:1.25"}},
+				},
+			},
+		},
+	}}
+
+	cases := []struct {
+		name         string
+		cluster      string
+		wantReplicas int64
+		wantImage    string
+		wantApplied  int
+		wantDiff     string
+	}{
+		{
+			name:         "plaintext overrider",
+			cluster:      "member1",
+			wantReplicas: 3,
+			wantImage:    "nginx:1.25",
+			wantApplied:  1,
+			wantDiff:     `{"spec":{"replicas":3}}`,
+		},
+		{
+			name:         "image overrider",
+			cluster:      "member2",
+			wantReplicas: 1,
+			wantImage:    "registry.example.io/nginx:1.25",
+			wantApplied:  1,
+			wantDiff:     `{"spec":{"template":{"spec":{"containers":[{"image":"registry.example.io/nginx:1.25","name":"nginx"}]}}}}`,
+		},
+		{
+			name:         "cluster not matched",
+			cluster:      "member3",
+			wantReplicas: 1,
+			wantImage:    "nginx:1.25",
+			wantDiff:     `{}`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			preview, err := GetOverridePreview(c, template, tc.cluster)
+			if err != nil {
+				t.Fatalf("GetOverridePreview() error = %v", err)
+			}
+			if replicas, _, _ := unstructured.NestedInt64(preview.Manifest.Object, "spec", "replicas"); replicas != tc.wantReplicas {
+				t.Errorf("GetOverridePreview() replicas = %d, want %d", replicas, tc.wantReplicas)
+			}
+			containers, _, _ := unstructured.NestedSlice(preview.Manifest.Object, "spec", "template", "spec", "containers")
+			if len(containers) != 1 || containers[0].(map[string]interface{})["image"] != tc.wantImage {
+				t.Errorf("GetOverridePreview() containers = %v, want image %s", containers, tc.wantImage)
+			}
+			if len(preview.AppliedOverrides) != tc.wantApplied || len(preview.AppliedClusterOverrides) != 0 {
+				t.Errorf("GetOverridePreview() applied = %v and %v, want %d OverridePolicies", preview.AppliedOverrides, preview.AppliedClusterOverrides, tc.wantApplied)
+			}
+			if string(preview.Diff) != tc.wantDiff {
+				t.Errorf("GetOverridePreview() diff = %s, want %s", preview.Diff, tc.wantDiff)
+			}
+		})
+	}
+	// the template itself must be left untouched
+	if replicas, _, _ := unstructured.NestedInt64(template.Object, "spec", "replicas"); replicas != 1 {
+		t.Errorf("GetOverridePreview() modified the template, replicas = %d", replicas)
+	}
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package overridepolicy
+
+import (
+	"github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/util/validation"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/klog/v2"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// Unmarshal decodes yaml into an OverridePolicy, or a ClusterOverridePolicy when isClusterScope is set,
+// and validates it.
+func Unmarshal(data string, isClusterScope bool, namespace string) (ctrlclient.Object, error) {
+	if isClusterScope {
+		clusterOverridePolicy := &v1alpha1.ClusterOverridePolicy{}
+		if err := yaml.Unmarshal([]byte(data), clusterOverridePolicy); err != nil {
+			klog.ErrorS(err, "Failed to unmarshal ClusterOverridePolicy")
+			return nil, err
+		}
+		if errs := ValidateOverridePolicy(clusterOverridePolicy.Name, "", &clusterOverridePolicy.Spec); len(errs) != 0 {
+			return nil, k8serrors.NewInvalid(v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.ResourceKindClusterOverridePolicy).GroupKind(),
+				clusterOverridePolicy.Name, errs)
+		}
+		return clusterOverridePolicy, nil
+	}
+	overridePolicy := &v1alpha1.OverridePolicy{}
+	if err := yaml.Unmarshal([]byte(data), overridePolicy); err != nil {
+		klog.ErrorS(err, "Failed to unmarshal OverridePolicy")
+		return nil, err
+	}
+	overridePolicy.Namespace = namespace
+	if errs := ValidateOverridePolicy(overridePolicy.Name, namespace, &overridePolicy.Spec); len(errs) != 0 {
+		return nil, k8serrors.NewInvalid(v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.ResourceKindOverridePolicy).GroupKind(),
+			overridePolicy.Name, errs)
+	}
+	return overridePolicy, nil
+}
+
+// SetDefaultOverrideSpec sets default values for the spec of OverridePolicy or ClusterOverridePolicy.
+func SetDefaultOverrideSpec(namespace string, spec *v1alpha1.OverrideSpec) {
+	// resource selectors of OverridePolicy only select resources in the namespace of policy.
+	if namespace == "" {
+		return
+	}
+	for i := range spec.ResourceSelectors {
+		if spec.ResourceSelectors[i].Namespace == "" {
+			spec.ResourceSelectors[i].Namespace = namespace
+		}
+	}
+}
+
+// ValidateOverridePolicy validates the name, namespace and spec of OverridePolicy or ClusterOverridePolicy,
+// ClusterOverridePolicy is validated when namespace is empty.
+func ValidateOverridePolicy(name, namespace string, spec *v1alpha1.OverrideSpec) field.ErrorList {
+	var allErrs field.ErrorList
+	metaPath := field.NewPath("metadata")
+	if name == "" {
+		allErrs = append(allErrs, field.Required(metaPath.Child("name"), ""))
+	} else {
+		for _, msg := range apimachineryvalidation.NameIsDNSSubdomain(name, false) {
+			allErrs = append(allErrs, field.Invalid(metaPath.Child("name"), name, msg))
+		}
+	}
+	if namespace != "" {
+		for _, msg := range apimachineryvalidation.ValidateNamespaceName(namespace, false) {
+			allErrs = append(allErrs, field.Invalid(metaPath.Child("namespace"), namespace, msg))
+		}
+	}
+
+	selectorsPath := field.NewPath("spec").Child("resourceSelectors")
+	for i, rs := range spec.ResourceSelectors {
+		if rs.APIVersion == "" {
+			allErrs = append(allErrs, field.Required(selectorsPath.Index(i).Child("apiVersion"), ""))
+		}
+		if rs.Kind == "" {
+			allErrs = append(allErrs, field.Required(selectorsPath.Index(i).Child("kind"), ""))
+		}
+		if namespace != "" && rs.Namespace != "" && rs.Namespace != namespace {
+			allErrs = append(allErrs, field.Invalid(selectorsPath.Index(i).Child("namespace"), rs.Namespace,
+				"OverridePolicy can only select resources in its own namespace"))
+		}
+	}
+
+	allErrs = append(allErrs, validation.ValidateOverrideSpec(spec)...)
+	return allErrs
+}
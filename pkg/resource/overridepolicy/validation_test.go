@@ -0,0 +1,142 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package overridepolicy
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestSetDefaultOverrideSpec(t *testing.T) {
+	cases := []struct {
+		name      string
+		namespace string
+		selectors []v1alpha1.ResourceSelector
+		expected  []v1alpha1.ResourceSelector
+	}{
+		{
+			name:      "namespace of selectors",
+			namespace: "default",
+			selectors: []v1alpha1.ResourceSelector{
+				{APIVersion: "apps/v1", Kind: "Deployment"},
+				{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default"},
+			},
+			expected: []v1alpha1.ResourceSelector{
+				{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default"},
+				{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default"},
+			},
+		},
+		{
+			name:      "cluster scope keeps selectors",
+			selectors: []v1alpha1.ResourceSelector{{APIVersion: "apps/v1", Kind: "Deployment"}},
+			expected:  []v1alpha1.ResourceSelector{{APIVersion: "apps/v1", Kind: "Deployment"}},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := &v1alpha1.OverrideSpec{ResourceSelectors: tc.selectors}
+			SetDefaultOverrideSpec(tc.namespace, spec)
+			if !reflect.DeepEqual(spec.ResourceSelectors, tc.expected) {
+				t.Errorf("SetDefaultOverrideSpec() = %+v, expected %+v", spec.ResourceSelectors, tc.expected)
+			}
+		})
+	}
+}
+
+func TestValidateOverridePolicy(t *testing.T) {
+	deployments := []v1alpha1.ResourceSelector{{APIVersion: "apps/v1", Kind: "Deployment"}}
+	rules := []v1alpha1.RuleWithCluster{{
+		TargetCluster: &v1alpha1.ClusterAffinity{ClusterNames: []string{"member1"}},
+		Overriders: v1alpha1.Overriders{
+			Plaintext: []v1alpha1.PlaintextOverrider{{Path: "/spec/replicas", Operator: v1alpha1.OverriderOpReplace}},
+		},
+	}}
+	cases := []struct {
+		name      string
+		policy    string
+		namespace string
+		spec      v1alpha1.OverrideSpec
+		expected  []string
+	}{
+		{name: "valid", policy: "nginx", namespace: "default", spec: v1alpha1.OverrideSpec{ResourceSelectors: deployments, OverrideRules: rules}},
+		{name: "valid cluster scope", policy: "nginx", spec: v1alpha1.OverrideSpec{OverrideRules: rules}},
+		{
+			name: "missing name", namespace: "default",
+			spec:     v1alpha1.OverrideSpec{ResourceSelectors: deployments},
+			expected: []string{"metadata.name"},
+		},
+		{
+			name: "invalid name", policy: "Nginx_1", namespace: "default",
+			spec:     v1alpha1.OverrideSpec{ResourceSelectors: deployments},
+			expected: []string{"metadata.name"},
+		},
+		{
+			name: "invalid namespace", policy: "nginx", namespace: "Default",
+			spec:     v1alpha1.OverrideSpec{ResourceSelectors: deployments},
+			expected: []string{"metadata.namespace"},
+		},
+		{
+			name: "incomplete selector", policy: "nginx", namespace: "default",
+			spec:     v1alpha1.OverrideSpec{ResourceSelectors: []v1alpha1.ResourceSelector{{}}},
+			expected: []string{"spec.resourceSelectors[0].apiVersion", "spec.resourceSelectors[0].kind"},
+		},
+		{
+			name: "selector in another namespace", policy: "nginx", namespace: "default",
+			spec: v1alpha1.OverrideSpec{ResourceSelectors: []v1alpha1.ResourceSelector{
+				{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "kube-system"},
+			}},
+			expected: []string{"spec.resourceSelectors[0].namespace"},
+		},
+		{
+			name: "target cluster with rules", policy: "nginx", namespace: "default",
+			spec: v1alpha1.OverrideSpec{
+				ResourceSelectors: deployments,
+				OverrideRules:     rules,
+				TargetCluster:     &v1alpha1.ClusterAffinity{ClusterNames: []string{"member1"}},
+			},
+			expected: []string{"spec.targetCluster"},
+		},
+		{
+			name: "invalid label", policy: "nginx", namespace: "default",
+			spec: v1alpha1.OverrideSpec{ResourceSelectors: deployments, OverrideRules: []v1alpha1.RuleWithCluster{{
+				Overriders: v1alpha1.Overriders{LabelsOverrider: []v1alpha1.LabelAnnotationOverrider{
+					{Operator: v1alpha1.OverriderOpAdd, Value: map[string]string{"app": "-nginx-"}},
+				}},
+			}}},
+			expected: []string{"spec.overrideRules[0].overriders.labelsOverrider[0].value"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := fieldPaths(ValidateOverridePolicy(tc.policy, tc.namespace, &tc.spec))
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("ValidateOverridePolicy() = %v, expected %v", actual, tc.expected)
+			}
+		})
+	}
+}
+
+func fieldPaths(errs field.ErrorList) []string {
+	var paths []string
+	for _, err := range errs {
+		paths = append(paths, err.Field)
+	}
+	return paths
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+)
+
+const (
+	// defaultContainerAnnotation names the container picked when none is given, as kubectl does.
+	defaultContainerAnnotation = "kubectl.kubernetes.io/default-container"
+	// maxLogLineSize is the longest log line read, longer lines are split.
+	maxLogLineSize = 1024 * 1024
+	// MaxMergedLogStreams bounds the number of containers whose logs are merged at once.
+	MaxMergedLogStreams = 100
+)
+
+// LogOptions are the options of reading the logs of a container.
+type LogOptions struct {
+	// Container to read the logs of, defaults to the default container of the pod.
+	Container string
+	// Follow keeps the stream open and returns new lines as they are written.
+	Follow bool
+	// TailLines is the number of lines from the end of the logs to start from.
+	TailLines *int64
+	// SinceTime only returns the lines written after it.
+	SinceTime *time.Time
+	// SinceSeconds only returns the lines written in the last seconds.
+	SinceSeconds *int64
+	// Previous reads the logs of the previous terminated container.
+	Previous bool
+	// Timestamps prefixes every line with its RFC3339 timestamp.
+	Timestamps bool
+}
+
+// LogSource identifies the container a log line comes from.
+type LogSource struct {
+	Cluster   string `json:"cluster"`
+	Namespace string `json:"namespace"`
+	Pod       string `json:"pod"`
+	Container string `json:"container"`
+}
+
+// Prefix returns the prefix of the lines of the source in merged logs.
+func (s LogSource) Prefix() string {
+	return fmt.Sprintf("[%s/%s/%s] ", s.Cluster, s.Pod, s.Container)
+}
+
+// LogLine is a line of the logs of a container.
+type LogLine struct {
+	Source  LogSource
+	Content string
+}
+
+// StreamPodLogs opens the log stream of a container of the pod.
+func StreamPodLogs(ctx context.Context, client kubernetes.Interface, namespace, name string, opts LogOptions) (io.ReadCloser, error) {
+	if opts.Container == "" {
+		pod, err := client.CoreV1().Pods(namespace).Get(ctx, name, metaV1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		opts.Container = DefaultContainer(pod)
+	}
+	var sinceTime *metaV1.Time
+	if opts.SinceTime != nil {
+		sinceTime = &metaV1.Time{Time: *opts.SinceTime}
+	}
+	return client.CoreV1().Pods(namespace).GetLogs(name, &v1.PodLogOptions{
+		Container:    opts.Container,
+		Follow:       opts.Follow,
+		TailLines:    opts.TailLines,
+		SinceTime:    sinceTime,
+		SinceSeconds: opts.SinceSeconds,
+		Previous:     opts.Previous,
+		Timestamps:   opts.Timestamps,
+	}).Stream(ctx)
+}
+
+// ReadLogLines sends the lines of the stream to out until the stream ends or ctx is done, the stream is closed.
+func ReadLogLines(ctx context.Context, source LogSource, stream io.ReadCloser, out chan<- LogLine) error {
+	defer stream.Close()
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLogLineSize)
+	for scanner.Scan() {
+		select {
+		case out <- LogLine{Source: source, Content: scanner.Text()}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return scanner.Err()
+}
+
+// MergeLogs streams the logs of all the sources concurrently into out, which is closed once every stream
+// has ended. A source failing to stream is reported as a line of its own. The clients of the clusters are
+// built before the streams start, clientFor is not called concurrently.
+func MergeLogs(ctx context.Context, sources []LogSource, clientFor common.ClientForCluster, opts LogOptions, out chan<- LogLine) {
+	clients := make(map[string]kubernetes.Interface)
+	for _, source := range sources {
+		if _, ok := clients[source.Cluster]; !ok {
+			clients[source.Cluster] = clientFor(source.Cluster)
+		}
+	}
+
+	var wg sync.WaitGroup
+	for _, source := range sources {
+		wg.Add(1)
+		go func(source LogSource, client kubernetes.Interface) {
+			defer wg.Done()
+			sourceOpts := opts
+			sourceOpts.Container = source.Container
+			stream, err := StreamPodLogs(ctx, client, source.Namespace, source.Pod, sourceOpts)
+			if err == nil {
+				err = ReadLogLines(ctx, source, stream, out)
+			}
+			if err != nil && ctx.Err() == nil {
+				select {
+				case out <- LogLine{Source: source, Content: fmt.Sprintf("failed to stream logs: %v", err)}:
+				case <-ctx.Done():
+				}
+			}
+		}(source, clients[source.Cluster])
+	}
+	wg.Wait()
+	close(out)
+}
+
+// GetWorkloadLogSources returns the containers of the pods of a propagated workload in every member
+// cluster it is scheduled to. Only the given container is returned when it is set.
+func GetWorkloadLogSources(ctx context.Context, karmadaClient karmadaclientset.Interface, clientFor common.ClientForCluster,
+	kind types.ResourceKind, namespace, name, container string) ([]LogSource, error) {
+	apiKind, ok := workloadKinds[kind]
+	if !ok {
+		return nil, errors.NewBadRequest(fmt.Sprintf("logs of %s are not supported", kind))
+	}
+	clusters, err := common.GetWorkloadDistribution(karmadaClient, namespace, apiKind, name)
+	if err != nil {
+		return nil, err
+	}
+
+	sources := make([]LogSource, 0)
+	for _, cluster := range clusters {
+		client := clientFor(cluster.Name)
+		selector, err := workloadSelector(ctx, client, kind, namespace, name)
+		if errors.IsNotFound(err) {
+			// not applied to the member cluster yet
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		pods, err := client.CoreV1().Pods(namespace).List(ctx, metaV1.ListOptions{LabelSelector: selector})
+		if err != nil {
+			return nil, err
+		}
+		for _, pod := range pods.Items {
+			for _, c := range pod.Spec.Containers {
+				if container != "" && c.Name != container {
+					continue
+				}
+				sources = append(sources, LogSource{Cluster: cluster.Name, Namespace: namespace, Pod: pod.Name, Container: c.Name})
+			}
+		}
+	}
+	return sources, nil
+}
+
+var workloadKinds = map[types.ResourceKind]string{
+	types.ResourceKindDeployment:  "Deployment",
+	types.ResourceKindStatefulSet: "StatefulSet",
+	types.ResourceKindDaemonSet:   "DaemonSet",
+	types.ResourceKindJob:         "Job",
+}
+
+func workloadSelector(ctx context.Context, client kubernetes.Interface, kind types.ResourceKind, namespace, name string) (string, error) {
+	var selector *metaV1.LabelSelector
+	switch kind {
+	case types.ResourceKindDeployment:
+		obj, err := client.AppsV1().Deployments(namespace).Get(ctx, name, metaV1.GetOptions{})
+		if err != nil {
+			return "", err
+		}
+		selector = obj.Spec.Selector
+	case types.ResourceKindStatefulSet:
+		obj, err := client.AppsV1().StatefulSets(namespace).Get(ctx, name, metaV1.GetOptions{})
+		if err != nil {
+			return "", err
+		}
+		selector = obj.Spec.Selector
+	case types.ResourceKindDaemonSet:
+		obj, err := client.AppsV1().DaemonSets(namespace).Get(ctx, name, metaV1.GetOptions{})
+		if err != nil {
+			return "", err
+		}
+		selector = obj.Spec.Selector
+	case types.ResourceKindJob:
+		obj, err := client.BatchV1().Jobs(namespace).Get(ctx, name, metaV1.GetOptions{})
+		if err != nil {
+			return "", err
+		}
+		selector = obj.Spec.Selector
+	}
+	if selector == nil {
+		return "", errors.NewBadRequest(fmt.Sprintf("%s %s/%s has no selector", kind, namespace, name))
+	}
+	labelSelector, err := metaV1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return "", err
+	}
+	return labelSelector.String(), nil
+}
+
+// DefaultContainer returns the container of the pod picked when none is given.
+func DefaultContainer(pod *v1.Pod) string {
+	if name := pod.Annotations[defaultContainerAnnotation]; name != "" {
+		return name
+	}
+	if len(pod.Spec.Containers) > 0 {
+		return pod.Spec.Containers[0].Name
+	}
+	return ""
+}
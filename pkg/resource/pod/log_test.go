@@ -0,0 +1,90 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"context"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestReadLogLines(t *testing.T) {
+	source := LogSource{Cluster: "member1", Namespace: "default", Pod: "nginx-0", Container: "nginx"}
+	out := make(chan LogLine, 3)
+	if err := ReadLogLines(context.TODO(), source, io.NopCloser(strings.NewReader("first\nsecond\n")), out); err != nil {
+		t.Fatalf("ReadLogLines() error = %v", err)
+	}
+	close(out)
+
+	var got []string
+	for line := range out {
+		got = append(got, line.Source.Prefix()+line.Content)
+	}
+	want := []string{"[member1/nginx-0/nginx] first", "[member1/nginx-0/nginx] second"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadLogLines() = %v, want %v", got, want)
+	}
+}
+
+func TestMergeLogs(t *testing.T) {
+	clients := map[string]*fake.Clientset{"member1": fake.NewSimpleClientset(), "member2": fake.NewSimpleClientset()}
+	sources := []LogSource{
+		{Cluster: "member1", Namespace: "default", Pod: "nginx-0", Container: "nginx"},
+		{Cluster: "member1", Namespace: "default", Pod: "nginx-1", Container: "nginx"},
+		{Cluster: "member2", Namespace: "default", Pod: "nginx-2", Container: "nginx"},
+	}
+	// clientFor is not safe for concurrent use, go test -race reports it when it's called from the streams
+	calls := make(map[string]int)
+	clientFor := func(cluster string) kubernetes.Interface {
+		calls[cluster]++
+		return clients[cluster]
+	}
+	out := make(chan LogLine)
+	go MergeLogs(context.TODO(), sources, clientFor, LogOptions{}, out)
+
+	var got []string
+	for line := range out {
+		got = append(got, line.Source.Prefix()+line.Content)
+	}
+	sort.Strings(got)
+	// the fake clientset answers every log request with "fake logs"
+	want := []string{"[member1/nginx-0/nginx] fake logs", "[member1/nginx-1/nginx] fake logs", "[member2/nginx-2/nginx] fake logs"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MergeLogs() = %v, want %v", got, want)
+	}
+	if want := map[string]int{"member1": 1, "member2": 1}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("clientFor() calls = %v, want %v", calls, want)
+	}
+	// every log is read from the cluster of its source
+	for cluster, client := range clients {
+		streams := 0
+		for _, action := range client.Actions() {
+			if action.GetSubresource() == "log" {
+				streams++
+			}
+		}
+		if want := map[string]int{"member1": 2, "member2": 1}[cluster]; streams != want {
+			t.Errorf("logs streamed from %s = %d, want %d", cluster, streams, want)
+		}
+	}
+}
@@ -0,0 +1,334 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/util"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// FindingType is the type of a problem found by the analyzer.
+type FindingType string
+
+const (
+	// FindingShadowed means the propagation policy matches resources but never wins any of them.
+	FindingShadowed FindingType = "Shadowed"
+	// FindingMatchesNothing means the policy does not match any resource.
+	FindingMatchesNothing FindingType = "MatchesNothing"
+	// FindingUnplacedTargetCluster means an override rule only targets clusters that the matched
+	// resources are never propagated to.
+	FindingUnplacedTargetCluster FindingType = "UnplacedTargetCluster"
+)
+
+// ResourceRef identifies a resource template.
+type ResourceRef struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+}
+
+func (r ResourceRef) String() string {
+	if r.Namespace == "" {
+		return fmt.Sprintf("%s %s", r.Kind, r.Name)
+	}
+	return fmt.Sprintf("%s %s/%s", r.Kind, r.Namespace, r.Name)
+}
+
+// Overlap describes a resource matched by more than one propagation policy.
+type Overlap struct {
+	Resource ResourceRef `json:"resource"`
+	// Winner is the policy that karmada picks for the resource.
+	Winner Key `json:"winner"`
+	// Candidates are all the policies matching the resource, ordered from the highest priority to the lowest.
+	Candidates []Key `json:"candidates"`
+}
+
+// Finding describes a problem of a single policy.
+type Finding struct {
+	Policy  Key         `json:"policy"`
+	Type    FindingType `json:"type"`
+	Message string      `json:"message"`
+}
+
+// AnalyzeInput contains all the objects taken into account by Analyze.
+type AnalyzeInput struct {
+	PropagationPolicies        []v1alpha1.PropagationPolicy
+	ClusterPropagationPolicies []v1alpha1.ClusterPropagationPolicy
+	OverridePolicies           []v1alpha1.OverridePolicy
+	ClusterOverridePolicies    []v1alpha1.ClusterOverridePolicy
+	Resources                  []*unstructured.Unstructured
+	Clusters                   []clusterv1alpha1.Cluster
+}
+
+// Report is the result of analyzing policies.
+type Report struct {
+	Overlaps []Overlap `json:"overlaps"`
+	Findings []Finding `json:"findings"`
+}
+
+// Warnings returns the messages of all findings of the given policy.
+func (r *Report) Warnings(key Key) []string {
+	warnings := make([]string, 0)
+	for _, finding := range r.Findings {
+		if finding.Policy == key {
+			warnings = append(warnings, finding.Message)
+		}
+	}
+	return warnings
+}
+
+// propagationCandidate is a propagation policy matching a resource.
+type propagationCandidate struct {
+	key              Key
+	explicitPriority int32
+	implicitPriority util.ImplicitPriority
+	placement        *v1alpha1.Placement
+}
+
+// higherThan tells whether c wins over o, it follows the order karmada uses when picking a
+// policy for a resource: explicit priority, then implicit priority, then name.
+func (c propagationCandidate) higherThan(o propagationCandidate) bool {
+	if c.explicitPriority != o.explicitPriority {
+		return c.explicitPriority > o.explicitPriority
+	}
+	if c.implicitPriority != o.implicitPriority {
+		return c.implicitPriority > o.implicitPriority
+	}
+	return c.key.Name < o.key.Name
+}
+
+// Analyze statically evaluates which propagation policy every resource is claimed by and
+// reports overlapping, shadowed and useless policies. Preemption and claims already made by
+// karmada are not taken into account, so the report shows what would happen if all resources
+// were matched from scratch.
+func Analyze(input *AnalyzeInput) *Report {
+	report := &Report{
+		Overlaps: make([]Overlap, 0),
+		Findings: make([]Finding, 0),
+	}
+	matched := sets.New[Key]()
+	winners := make(map[Key]sets.Set[string])
+	placements := make(map[ResourceRef]*v1alpha1.Placement)
+
+	for _, resource := range input.Resources {
+		ref := toResourceRef(resource)
+		// namespace scoped policies always take precedence over cluster scoped ones.
+		namespaced := make([]propagationCandidate, 0)
+		for i := range input.PropagationPolicies {
+			p := &input.PropagationPolicies[i]
+			if p.Namespace != resource.GetNamespace() {
+				continue
+			}
+			if priority := util.ResourceMatchSelectorsPriority(resource, p.Spec.ResourceSelectors...); priority > util.PriorityMisMatch {
+				namespaced = append(namespaced, propagationCandidate{
+					key:              Key{Kind: v1alpha1.ResourceKindPropagationPolicy, Namespace: p.Namespace, Name: p.Name},
+					explicitPriority: p.ExplicitPriority(),
+					implicitPriority: priority,
+					placement:        &p.Spec.Placement,
+				})
+			}
+		}
+		clusterScoped := make([]propagationCandidate, 0)
+		for i := range input.ClusterPropagationPolicies {
+			p := &input.ClusterPropagationPolicies[i]
+			if priority := util.ResourceMatchSelectorsPriority(resource, p.Spec.ResourceSelectors...); priority > util.PriorityMisMatch {
+				clusterScoped = append(clusterScoped, propagationCandidate{
+					key:              Key{Kind: v1alpha1.ResourceKindClusterPropagationPolicy, Name: p.Name},
+					explicitPriority: p.ExplicitPriority(),
+					implicitPriority: priority,
+					placement:        &p.Spec.Placement,
+				})
+			}
+		}
+		sortCandidates(namespaced)
+		sortCandidates(clusterScoped)
+		candidates := append(namespaced, clusterScoped...)
+		if len(candidates) == 0 {
+			continue
+		}
+
+		winner := candidates[0]
+		placements[ref] = winner.placement
+		if winners[winner.key] == nil {
+			winners[winner.key] = sets.New[string]()
+		}
+		winners[winner.key].Insert(ref.String())
+		keys := make([]Key, 0, len(candidates))
+		for _, candidate := range candidates {
+			matched.Insert(candidate.key)
+			keys = append(keys, candidate.key)
+		}
+		if len(candidates) > 1 {
+			report.Overlaps = append(report.Overlaps, Overlap{
+				Resource:   ref,
+				Winner:     winner.key,
+				Candidates: keys,
+			})
+		}
+	}
+
+	shadowedBy := func(key Key) []string {
+		result := sets.New[string]()
+		for _, overlap := range report.Overlaps {
+			for _, candidate := range overlap.Candidates {
+				if candidate == key {
+					result.Insert(overlap.Winner.String())
+				}
+			}
+		}
+		return sets.List(result)
+	}
+	checkPropagationPolicy := func(key Key) {
+		if !matched.Has(key) {
+			report.Findings = append(report.Findings, Finding{
+				Policy:  key,
+				Type:    FindingMatchesNothing,
+				Message: "policy does not match any resource",
+			})
+			return
+		}
+		if winners[key].Len() == 0 {
+			report.Findings = append(report.Findings, Finding{
+				Policy:  key,
+				Type:    FindingShadowed,
+				Message: fmt.Sprintf("policy never takes effect, all matched resources are claimed by %s", strings.Join(shadowedBy(key), ", ")),
+			})
+		}
+	}
+	for i := range input.PropagationPolicies {
+		p := &input.PropagationPolicies[i]
+		checkPropagationPolicy(Key{Kind: v1alpha1.ResourceKindPropagationPolicy, Namespace: p.Namespace, Name: p.Name})
+	}
+	for i := range input.ClusterPropagationPolicies {
+		p := &input.ClusterPropagationPolicies[i]
+		checkPropagationPolicy(Key{Kind: v1alpha1.ResourceKindClusterPropagationPolicy, Name: p.Name})
+	}
+
+	for i := range input.OverridePolicies {
+		p := &input.OverridePolicies[i]
+		key := Key{Kind: v1alpha1.ResourceKindOverridePolicy, Namespace: p.Namespace, Name: p.Name}
+		report.Findings = append(report.Findings, analyzeOverridePolicy(key, p.Namespace, &p.Spec, input, placements)...)
+	}
+	for i := range input.ClusterOverridePolicies {
+		p := &input.ClusterOverridePolicies[i]
+		key := Key{Kind: v1alpha1.ResourceKindClusterOverridePolicy, Name: p.Name}
+		report.Findings = append(report.Findings, analyzeOverridePolicy(key, "", &p.Spec, input, placements)...)
+	}
+	return report
+}
+
+func analyzeOverridePolicy(key Key, namespace string, spec *v1alpha1.OverrideSpec, input *AnalyzeInput,
+	placements map[ResourceRef]*v1alpha1.Placement) []Finding {
+	placed := sets.New[string]()
+	matchedAny := false
+	for _, resource := range input.Resources {
+		if namespace != "" && resource.GetNamespace() != namespace {
+			continue
+		}
+		if len(spec.ResourceSelectors) != 0 && !util.ResourceMatchSelectors(resource, spec.ResourceSelectors...) {
+			continue
+		}
+		placement, ok := placements[toResourceRef(resource)]
+		if !ok {
+			// the resource is not propagated at all, overrides never apply on it.
+			continue
+		}
+		matchedAny = true
+		placed.Insert(placementClusters(placement, input.Clusters)...)
+	}
+	if !matchedAny {
+		return []Finding{{
+			Policy:  key,
+			Type:    FindingMatchesNothing,
+			Message: "policy does not match any propagated resource",
+		}}
+	}
+
+	findings := make([]Finding, 0)
+	rules := spec.OverrideRules
+	if spec.TargetCluster != nil {
+		rules = append([]v1alpha1.RuleWithCluster{{TargetCluster: spec.TargetCluster}}, rules...)
+	}
+	for i, rule := range rules {
+		if rule.TargetCluster == nil {
+			continue
+		}
+		targets := sets.New[string]()
+		for j := range input.Clusters {
+			if util.ClusterMatches(&input.Clusters[j], *rule.TargetCluster) {
+				targets.Insert(input.Clusters[j].Name)
+			}
+		}
+		if targets.Len() != 0 && !targets.HasAny(sets.List(placed)...) {
+			findings = append(findings, Finding{
+				Policy: key,
+				Type:   FindingUnplacedTargetCluster,
+				Message: fmt.Sprintf("rule %d targets clusters %s, but no propagation policy places the matched resources on them",
+					i, strings.Join(sets.List(targets), ", ")),
+			})
+		}
+	}
+	return findings
+}
+
+// placementClusters returns the names of the clusters that the placement may schedule resources to.
+func placementClusters(placement *v1alpha1.Placement, clusters []clusterv1alpha1.Cluster) []string {
+	affinities := make([]v1alpha1.ClusterAffinity, 0)
+	if placement.ClusterAffinity != nil {
+		affinities = append(affinities, *placement.ClusterAffinity)
+	}
+	for _, term := range placement.ClusterAffinities {
+		affinities = append(affinities, term.ClusterAffinity)
+	}
+	names := make([]string, 0, len(clusters))
+	for i := range clusters {
+		if len(affinities) == 0 {
+			names = append(names, clusters[i].Name)
+			continue
+		}
+		for _, affinity := range affinities {
+			if util.ClusterMatches(&clusters[i], affinity) {
+				names = append(names, clusters[i].Name)
+				break
+			}
+		}
+	}
+	return names
+}
+
+func sortCandidates(candidates []propagationCandidate) {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].higherThan(candidates[j])
+	})
+}
+
+func toResourceRef(resource *unstructured.Unstructured) ResourceRef {
+	return ResourceRef{
+		APIVersion: resource.GetAPIVersion(),
+		Kind:       resource.GetKind(),
+		Namespace:  resource.GetNamespace(),
+		Name:       resource.GetName(),
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"reflect"
+	"testing"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newDeployment(namespace, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("apps/v1")
+	obj.SetKind("Deployment")
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	return obj
+}
+
+func deploymentSelector(name string) []v1alpha1.ResourceSelector {
+	return []v1alpha1.ResourceSelector{{APIVersion: "apps/v1", Kind: "Deployment", Name: name}}
+}
+
+func TestAnalyze(t *testing.T) {
+	input := &AnalyzeInput{
+		PropagationPolicies: []v1alpha1.PropagationPolicy{
+			{
+				ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "by-name"},
+				Spec: v1alpha1.PropagationSpec{
+					ResourceSelectors: deploymentSelector("nginx"),
+					Placement: v1alpha1.Placement{
+						ClusterAffinity: &v1alpha1.ClusterAffinity{ClusterNames: []string{"member1"}},
+					},
+				},
+			},
+			{
+				ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "all"},
+				Spec:       v1alpha1.PropagationSpec{ResourceSelectors: deploymentSelector("")},
+			},
+			{
+				ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "missing"},
+				Spec:       v1alpha1.PropagationSpec{ResourceSelectors: deploymentSelector("missing")},
+			},
+		},
+		ClusterPropagationPolicies: []v1alpha1.ClusterPropagationPolicy{
+			{
+				ObjectMeta: metaV1.ObjectMeta{Name: "cluster-all"},
+				Spec:       v1alpha1.PropagationSpec{ResourceSelectors: deploymentSelector("")},
+			},
+		},
+		OverridePolicies: []v1alpha1.OverridePolicy{
+			{
+				ObjectMeta: metaV1.ObjectMeta{Namespace: "default", Name: "member2-only"},
+				Spec: v1alpha1.OverrideSpec{
+					ResourceSelectors: deploymentSelector("nginx"),
+					OverrideRules: []v1alpha1.RuleWithCluster{
+						{TargetCluster: &v1alpha1.ClusterAffinity{ClusterNames: []string{"member2"}}},
+					},
+				},
+			},
+		},
+		Resources: []*unstructured.Unstructured{newDeployment("default", "nginx")},
+		Clusters: []clusterv1alpha1.Cluster{
+			{ObjectMeta: metaV1.ObjectMeta{Name: "member1"}},
+			{ObjectMeta: metaV1.ObjectMeta{Name: "member2"}},
+		},
+	}
+	byName := Key{Kind: v1alpha1.ResourceKindPropagationPolicy, Namespace: "default", Name: "by-name"}
+	all := Key{Kind: v1alpha1.ResourceKindPropagationPolicy, Namespace: "default", Name: "all"}
+	missing := Key{Kind: v1alpha1.ResourceKindPropagationPolicy, Namespace: "default", Name: "missing"}
+	clusterAll := Key{Kind: v1alpha1.ResourceKindClusterPropagationPolicy, Name: "cluster-all"}
+	member2Only := Key{Kind: v1alpha1.ResourceKindOverridePolicy, Namespace: "default", Name: "member2-only"}
+
+	expected := &Report{
+		Overlaps: []Overlap{{
+			Resource:   ResourceRef{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "nginx"},
+			Winner:     byName,
+			Candidates: []Key{byName, all, clusterAll},
+		}},
+		Findings: []Finding{
+			{Policy: all, Type: FindingShadowed, Message: "policy never takes effect, all matched resources are claimed by PropagationPolicy default/by-name"},
+			{Policy: missing, Type: FindingMatchesNothing, Message: "policy does not match any resource"},
+			{Policy: clusterAll, Type: FindingShadowed, Message: "policy never takes effect, all matched resources are claimed by PropagationPolicy default/by-name"},
+			{Policy: member2Only, Type: FindingUnplacedTargetCluster, Message: "rule 0 targets clusters member2, but no propagation policy places the matched resources on them"},
+		},
+	}
+	actual := Analyze(input)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Analyze() == \n%#v\nexpected \n%#v\n", actual, expected)
+	}
+
+	// explicit priority takes precedence over implicit priority.
+	priority := int32(1)
+	input.PropagationPolicies[1].Spec.Priority = &priority
+	actual = Analyze(input)
+	if actual.Overlaps[0].Winner != all {
+		t.Errorf("Analyze() picks %v, expected %v", actual.Overlaps[0].Winner, all)
+	}
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+)
+
+const (
+	// DefaultDeleteTimeout is the default time to wait for a policy to disappear after deletion.
+	DefaultDeleteTimeout = 30 * time.Second
+	deletePollInterval   = 500 * time.Millisecond
+)
+
+// Key identifies a policy of any of the four policy kinds, namespace is ignored for cluster scoped kinds.
+type Key struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+}
+
+// PropagationPolicyKey returns the key of a PropagationPolicy or a ClusterPropagationPolicy.
+func PropagationPolicyKey(isClusterScope bool, namespace, name string) Key {
+	if isClusterScope {
+		return Key{Kind: v1alpha1.ResourceKindClusterPropagationPolicy, Name: name}
+	}
+	return Key{Kind: v1alpha1.ResourceKindPropagationPolicy, Namespace: namespace, Name: name}
+}
+
+// OverridePolicyKey returns the key of an OverridePolicy or a ClusterOverridePolicy.
+func OverridePolicyKey(isClusterScope bool, namespace, name string) Key {
+	if isClusterScope {
+		return Key{Kind: v1alpha1.ResourceKindClusterOverridePolicy, Name: name}
+	}
+	return Key{Kind: v1alpha1.ResourceKindOverridePolicy, Namespace: namespace, Name: name}
+}
+
+func (k Key) objectKey() ctrlclient.ObjectKey {
+	return ctrlclient.ObjectKey{Namespace: k.Namespace, Name: k.Name}
+}
+
+func (k Key) String() string {
+	if k.Namespace == "" {
+		return fmt.Sprintf("%s %s", k.Kind, k.Name)
+	}
+	return fmt.Sprintf("%s %s/%s", k.Kind, k.Namespace, k.Name)
+}
+
+// NewObject returns an empty object of the given policy kind.
+func NewObject(kind string) (ctrlclient.Object, error) {
+	switch kind {
+	case v1alpha1.ResourceKindPropagationPolicy:
+		return &v1alpha1.PropagationPolicy{}, nil
+	case v1alpha1.ResourceKindClusterPropagationPolicy:
+		return &v1alpha1.ClusterPropagationPolicy{}, nil
+	case v1alpha1.ResourceKindOverridePolicy:
+		return &v1alpha1.OverridePolicy{}, nil
+	case v1alpha1.ResourceKindClusterOverridePolicy:
+		return &v1alpha1.ClusterOverridePolicy{}, nil
+	}
+	return nil, errors.NewBadRequest(fmt.Sprintf("unsupported policy kind %q", kind))
+}
+
+// Get returns the policy identified by key.
+func Get(ctx context.Context, c ctrlclient.Client, key Key) (ctrlclient.Object, error) {
+	obj, err := NewObject(key.Kind)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.Get(ctx, key.objectKey(), obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// Create creates the given policy.
+func Create(ctx context.Context, c ctrlclient.Client, obj ctrlclient.Object) error {
+	obj.SetResourceVersion("")
+	return c.Create(ctx, obj)
+}
+
+// Update fetches the policy identified by key, applies mutate on it and writes it back.
+// The resourceVersion is required and used as precondition of the update, so that a
+// stale write is rejected by karmada apiserver with a conflict error.
+func Update(ctx context.Context, c ctrlclient.Client, key Key, resourceVersion string, mutate func(obj ctrlclient.Object) error) (ctrlclient.Object, error) {
+	if resourceVersion == "" {
+		return nil, k8serrors.NewInvalid(v1alpha1.SchemeGroupVersion.WithKind(key.Kind).GroupKind(), key.Name, field.ErrorList{
+			field.Required(field.NewPath("metadata", "resourceVersion"), "the resourceVersion the policy was read at is required"),
+		})
+	}
+	obj, err := Get(ctx, c, key)
+	if err != nil {
+		return nil, err
+	}
+	obj.SetResourceVersion(resourceVersion)
+	if err = mutate(obj); err != nil {
+		return nil, err
+	}
+	if err = c.Update(ctx, obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// UpdateSpec replaces spec, labels and annotations of the policy identified by key with those of desired,
+// the labels and annotations managed by karmada are kept.
+func UpdateSpec(ctx context.Context, c ctrlclient.Client, key Key, resourceVersion string, desired ctrlclient.Object) (ctrlclient.Object, error) {
+	return Update(ctx, c, key, resourceVersion, func(obj ctrlclient.Object) error {
+		if err := copySpec(obj, desired); err != nil {
+			return err
+		}
+		obj.SetLabels(keepKarmadaKeys(obj.GetLabels(), desired.GetLabels()))
+		obj.SetAnnotations(keepKarmadaKeys(obj.GetAnnotations(), desired.GetAnnotations()))
+		return nil
+	})
+}
+
+// UpdateMetadata replaces labels and annotations of the policy identified by key, the labels and
+// annotations managed by karmada are kept.
+func UpdateMetadata(ctx context.Context, c ctrlclient.Client, key Key, resourceVersion string, labels, annotations map[string]string) (ctrlclient.Object, error) {
+	return Update(ctx, c, key, resourceVersion, func(obj ctrlclient.Object) error {
+		obj.SetLabels(keepKarmadaKeys(obj.GetLabels(), labels))
+		obj.SetAnnotations(keepKarmadaKeys(obj.GetAnnotations(), annotations))
+		return nil
+	})
+}
+
+// keepKarmadaKeys returns desired with the keys of the karmada.io domain copied from existing. Karmada
+// relies on them, e.g. the webhook rejects an update which removes or changes the permanent-id label.
+func keepKarmadaKeys(existing, desired map[string]string) map[string]string {
+	result := make(map[string]string, len(desired))
+	for k, v := range desired {
+		result[k] = v
+	}
+	for k, v := range existing {
+		if isKarmadaKey(k) {
+			result[k] = v
+		}
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
+
+func isKarmadaKey(key string) bool {
+	prefix, _, found := strings.Cut(key, "/")
+	return found && (prefix == "karmada.io" || strings.HasSuffix(prefix, ".karmada.io"))
+}
+
+// Delete deletes the policy identified by key and waits until it is gone, so that the
+// finalizers of the policy get a chance to run. An error is returned if the policy still
+// exists after timeout.
+func Delete(ctx context.Context, c ctrlclient.Client, key Key, timeout time.Duration) error {
+	obj, err := NewObject(key.Kind)
+	if err != nil {
+		return err
+	}
+	obj.SetNamespace(key.Namespace)
+	obj.SetName(key.Name)
+	if err = c.Delete(ctx, obj); err != nil {
+		return err
+	}
+	if timeout <= 0 {
+		timeout = DefaultDeleteTimeout
+	}
+	err = wait.PollUntilContextTimeout(ctx, deletePollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		getErr := c.Get(ctx, key.objectKey(), obj)
+		if k8serrors.IsNotFound(getErr) {
+			return true, nil
+		}
+		return false, getErr
+	})
+	if err != nil && wait.Interrupted(err) {
+		return k8serrors.NewTimeoutError(fmt.Sprintf("%s is still terminating, pending finalizers: %v",
+			key, obj.GetFinalizers()), 0)
+	}
+	return err
+}
+
+func copySpec(dst, src ctrlclient.Object) error {
+	switch d := dst.(type) {
+	case *v1alpha1.PropagationPolicy:
+		if s, ok := src.(*v1alpha1.PropagationPolicy); ok {
+			d.Spec = s.Spec
+			return nil
+		}
+	case *v1alpha1.ClusterPropagationPolicy:
+		if s, ok := src.(*v1alpha1.ClusterPropagationPolicy); ok {
+			d.Spec = s.Spec
+			return nil
+		}
+	case *v1alpha1.OverridePolicy:
+		if s, ok := src.(*v1alpha1.OverridePolicy); ok {
+			d.Spec = s.Spec
+			return nil
+		}
+	case *v1alpha1.ClusterOverridePolicy:
+		if s, ok := src.(*v1alpha1.ClusterOverridePolicy); ok {
+			d.Spec = s.Spec
+			return nil
+		}
+	}
+	return errors.NewBadRequest(fmt.Sprintf("cannot update %T with %T", dst, src))
+}
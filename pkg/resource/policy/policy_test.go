@@ -0,0 +1,120 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/util/gclient"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestUpdateKeepsKarmadaMetadata(t *testing.T) {
+	stored := &v1alpha1.PropagationPolicy{
+		ObjectMeta: metaV1.ObjectMeta{
+			Namespace: "default",
+			Name:      "nginx",
+			Labels: map[string]string{
+				v1alpha1.PropagationPolicyPermanentIDLabel: "e6e1c1a4",
+				"app": "nginx",
+			},
+			Annotations: map[string]string{
+				"policy.karmada.io/applied-placement": "{}",
+				"note":                                "old",
+			},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(gclient.NewSchema()).WithObjects(stored).Build()
+	key := PropagationPolicyKey(false, "default", "nginx")
+
+	desired := &v1alpha1.PropagationPolicy{
+		ObjectMeta: metaV1.ObjectMeta{
+			Namespace:   "default",
+			Name:        "nginx",
+			Labels:      map[string]string{"team": "web"},
+			Annotations: map[string]string{"note": "new"},
+		},
+		Spec: v1alpha1.PropagationSpec{ResourceSelectors: []v1alpha1.ResourceSelector{{APIVersion: "apps/v1", Kind: "Deployment"}}},
+	}
+	// the permanent-id can't be changed by the user
+	desired.Labels[v1alpha1.PropagationPolicyPermanentIDLabel] = "changed"
+	current, err := Get(context.TODO(), c, key)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	obj, err := UpdateSpec(context.TODO(), c, key, current.GetResourceVersion(), desired)
+	if err != nil {
+		t.Fatalf("UpdateSpec() error = %v", err)
+	}
+	expectedLabels := map[string]string{v1alpha1.PropagationPolicyPermanentIDLabel: "e6e1c1a4", "team": "web"}
+	if !reflect.DeepEqual(obj.GetLabels(), expectedLabels) {
+		t.Errorf("UpdateSpec() labels = %v, expected %v", obj.GetLabels(), expectedLabels)
+	}
+	expectedAnnotations := map[string]string{"policy.karmada.io/applied-placement": "{}", "note": "new"}
+	if !reflect.DeepEqual(obj.GetAnnotations(), expectedAnnotations) {
+		t.Errorf("UpdateSpec() annotations = %v, expected %v", obj.GetAnnotations(), expectedAnnotations)
+	}
+	if len(obj.(*v1alpha1.PropagationPolicy).Spec.ResourceSelectors) != 1 {
+		t.Errorf("UpdateSpec() spec is not updated")
+	}
+
+	obj, err = UpdateMetadata(context.TODO(), c, key, obj.GetResourceVersion(), nil, nil)
+	if err != nil {
+		t.Fatalf("UpdateMetadata() error = %v", err)
+	}
+	expectedLabels = map[string]string{v1alpha1.PropagationPolicyPermanentIDLabel: "e6e1c1a4"}
+	if !reflect.DeepEqual(obj.GetLabels(), expectedLabels) {
+		t.Errorf("UpdateMetadata() labels = %v, expected %v", obj.GetLabels(), expectedLabels)
+	}
+	expectedAnnotations = map[string]string{"policy.karmada.io/applied-placement": "{}"}
+	if !reflect.DeepEqual(obj.GetAnnotations(), expectedAnnotations) {
+		t.Errorf("UpdateMetadata() annotations = %v, expected %v", obj.GetAnnotations(), expectedAnnotations)
+	}
+}
+
+func TestUpdateRequiresResourceVersion(t *testing.T) {
+	stored := &v1alpha1.ClusterPropagationPolicy{ObjectMeta: metaV1.ObjectMeta{Name: "nginx"}}
+	c := fake.NewClientBuilder().WithScheme(gclient.NewSchema()).WithObjects(stored).Build()
+	key := PropagationPolicyKey(true, "", "nginx")
+	current, err := Get(context.TODO(), c, key)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	resourceVersion := current.GetResourceVersion()
+
+	cases := []struct {
+		name            string
+		resourceVersion string
+		check           func(error) bool
+	}{
+		{name: "without resourceVersion", resourceVersion: "", check: k8serrors.IsInvalid},
+		{name: "current resourceVersion", resourceVersion: resourceVersion, check: func(err error) bool { return err == nil }},
+		{name: "stale resourceVersion", resourceVersion: resourceVersion, check: k8serrors.IsConflict},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := UpdateMetadata(context.TODO(), c, key, tc.resourceVersion, map[string]string{"team": "web"}, nil); !tc.check(err) {
+				t.Errorf("UpdateMetadata() error = %v", err)
+			}
+		})
+	}
+}
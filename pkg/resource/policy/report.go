@@ -0,0 +1,112 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"sort"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GetAnalyzeReport collects all policies, clusters and the resources selected by propagation
+// policies from karmada apiserver, and analyzes them.
+func GetAnalyzeReport(ctx context.Context, c ctrlclient.Client) (*Report, error) {
+	input := &AnalyzeInput{}
+	ppList := &v1alpha1.PropagationPolicyList{}
+	if err := c.List(ctx, ppList); err != nil {
+		return nil, err
+	}
+	input.PropagationPolicies = ppList.Items
+	cppList := &v1alpha1.ClusterPropagationPolicyList{}
+	if err := c.List(ctx, cppList); err != nil {
+		return nil, err
+	}
+	input.ClusterPropagationPolicies = cppList.Items
+	opList := &v1alpha1.OverridePolicyList{}
+	if err := c.List(ctx, opList); err != nil {
+		return nil, err
+	}
+	input.OverridePolicies = opList.Items
+	copList := &v1alpha1.ClusterOverridePolicyList{}
+	if err := c.List(ctx, copList); err != nil {
+		return nil, err
+	}
+	input.ClusterOverridePolicies = copList.Items
+	clusterList := &clusterv1alpha1.ClusterList{}
+	if err := c.List(ctx, clusterList); err != nil {
+		return nil, err
+	}
+	input.Clusters = clusterList.Items
+
+	// only the kinds referenced by propagation policies need to be listed, and namespace
+	// scoped policies only see resources in their own namespace.
+	scopes := make(map[schema.GroupVersionKind]map[string]bool)
+	addScope := func(selectors []v1alpha1.ResourceSelector, namespace string) {
+		for _, rs := range selectors {
+			gvk := schema.FromAPIVersionAndKind(rs.APIVersion, rs.Kind)
+			if scopes[gvk] == nil {
+				scopes[gvk] = make(map[string]bool)
+			}
+			if namespace != "" {
+				scopes[gvk][namespace] = true
+			} else {
+				scopes[gvk][rs.Namespace] = true
+			}
+		}
+	}
+	for i := range input.PropagationPolicies {
+		addScope(input.PropagationPolicies[i].Spec.ResourceSelectors, input.PropagationPolicies[i].Namespace)
+	}
+	for i := range input.ClusterPropagationPolicies {
+		addScope(input.ClusterPropagationPolicies[i].Spec.ResourceSelectors, "")
+	}
+
+	seen := make(map[ResourceRef]bool)
+	for gvk, namespaces := range scopes {
+		if namespaces[""] {
+			namespaces = map[string]bool{"": true}
+		}
+		for namespace := range namespaces {
+			list := &unstructured.UnstructuredList{}
+			list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+			if err := c.List(ctx, list, ctrlclient.InNamespace(namespace)); err != nil {
+				// a policy may reference a kind that is not installed, skip it instead of failing the whole report.
+				klog.ErrorS(err, "Failed to list resources for policy analysis", "gvk", gvk, "namespace", namespace)
+				continue
+			}
+			for i := range list.Items {
+				resource := &list.Items[i]
+				ref := toResourceRef(resource)
+				if seen[ref] {
+					continue
+				}
+				seen[ref] = true
+				input.Resources = append(input.Resources, resource)
+			}
+		}
+	}
+	sort.Slice(input.Resources, func(i, j int) bool {
+		return toResourceRef(input.Resources[i]).String() < toResourceRef(input.Resources[j]).String()
+	})
+	return Analyze(input), nil
+}
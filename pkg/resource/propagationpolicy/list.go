@@ -53,6 +53,9 @@ type PropagationPolicy struct {
 	SchedulerName    string                    `json:"schedulerName"`
 	ClusterAffinity  *v1alpha1.ClusterAffinity `json:"clusterAffinity"`
 	RelatedResources []string                  `json:"relatedResources"`
+	// Warnings are the problems of the policy reported by policy analyzer, only set when the list is
+	// requested with analyze=true.
+	Warnings []string `json:"warnings"`
 }
 
 // GetPropagationPolicyList returns a list of all propagations in the karmada control-plance.
@@ -0,0 +1,132 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package propagationpolicy
+
+import (
+	"github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/util/helper"
+	"github.com/karmada-io/karmada/pkg/util/validation"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// Unmarshal decodes yaml into a PropagationPolicy, or a ClusterPropagationPolicy when isClusterScope is set,
+// and validates it.
+func Unmarshal(data string, isClusterScope bool, namespace string) (ctrlclient.Object, error) {
+	if isClusterScope {
+		clusterPropagationPolicy := &v1alpha1.ClusterPropagationPolicy{}
+		if err := yaml.Unmarshal([]byte(data), clusterPropagationPolicy); err != nil {
+			klog.ErrorS(err, "Failed to unmarshal ClusterPropagationPolicy")
+			return nil, err
+		}
+		if errs := ValidatePropagationPolicy(clusterPropagationPolicy.Name, "", &clusterPropagationPolicy.Spec); len(errs) != 0 {
+			return nil, k8serrors.NewInvalid(v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.ResourceKindClusterPropagationPolicy).GroupKind(),
+				clusterPropagationPolicy.Name, errs)
+		}
+		return clusterPropagationPolicy, nil
+	}
+	propagationPolicy := &v1alpha1.PropagationPolicy{}
+	if err := yaml.Unmarshal([]byte(data), propagationPolicy); err != nil {
+		klog.ErrorS(err, "Failed to unmarshal PropagationPolicy")
+		return nil, err
+	}
+	propagationPolicy.Namespace = namespace
+	if errs := ValidatePropagationPolicy(propagationPolicy.Name, namespace, &propagationPolicy.Spec); len(errs) != 0 {
+		return nil, k8serrors.NewInvalid(v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.ResourceKindPropagationPolicy).GroupKind(),
+			propagationPolicy.Name, errs)
+	}
+	return propagationPolicy, nil
+}
+
+// SetDefaultPropagationSpec sets default values for the spec of PropagationPolicy or ClusterPropagationPolicy,
+// it follows the mutating webhook of karmada so that the frontend can display the spec which will be persisted.
+func SetDefaultPropagationSpec(namespace string, spec *v1alpha1.PropagationSpec) {
+	// resource selectors of PropagationPolicy only select resources in the namespace of policy.
+	if namespace != "" {
+		for i := range spec.ResourceSelectors {
+			if spec.ResourceSelectors[i].Namespace == "" {
+				spec.ResourceSelectors[i].Namespace = namespace
+			}
+		}
+	}
+	helper.SetDefaultSpreadConstraints(spec.Placement.SpreadConstraints)
+	if helper.ContainsServiceImport(spec.ResourceSelectors) {
+		spec.PropagateDeps = true
+	}
+	helper.SetReplicaDivisionPreferenceWeighted(&spec.Placement)
+	if spec.Failover != nil && spec.Failover.Application != nil {
+		setDefaultTolerationSeconds(spec.Failover.Application)
+		helper.SetDefaultGracePeriodSeconds(spec.Failover.Application)
+	}
+}
+
+// setDefaultTolerationSeconds sets the default of the CRD, the validation of karmada panics without it.
+func setDefaultTolerationSeconds(behavior *v1alpha1.ApplicationFailoverBehavior) {
+	if behavior.DecisionConditions.TolerationSeconds == nil {
+		behavior.DecisionConditions.TolerationSeconds = ptr.To[int32](300)
+	}
+}
+
+// ValidatePropagationPolicy validates the name, namespace and spec of PropagationPolicy or ClusterPropagationPolicy,
+// ClusterPropagationPolicy is validated when namespace is empty.
+func ValidatePropagationPolicy(name, namespace string, spec *v1alpha1.PropagationSpec) field.ErrorList {
+	var allErrs field.ErrorList
+	metaPath := field.NewPath("metadata")
+	if name == "" {
+		allErrs = append(allErrs, field.Required(metaPath.Child("name"), ""))
+	} else {
+		for _, msg := range apimachineryvalidation.NameIsDNSSubdomain(name, false) {
+			allErrs = append(allErrs, field.Invalid(metaPath.Child("name"), name, msg))
+		}
+	}
+	if namespace != "" {
+		for _, msg := range apimachineryvalidation.ValidateNamespaceName(namespace, false) {
+			allErrs = append(allErrs, field.Invalid(metaPath.Child("namespace"), namespace, msg))
+		}
+	}
+
+	selectorsPath := field.NewPath("spec").Child("resourceSelectors")
+	if len(spec.ResourceSelectors) == 0 {
+		allErrs = append(allErrs, field.Required(selectorsPath, "at least one resource selector is required"))
+	}
+	for i, rs := range spec.ResourceSelectors {
+		if rs.APIVersion == "" {
+			allErrs = append(allErrs, field.Required(selectorsPath.Index(i).Child("apiVersion"), ""))
+		}
+		if rs.Kind == "" {
+			allErrs = append(allErrs, field.Required(selectorsPath.Index(i).Child("kind"), ""))
+		}
+		if namespace != "" && rs.Namespace != "" && rs.Namespace != namespace {
+			allErrs = append(allErrs, field.Invalid(selectorsPath.Index(i).Child("namespace"), rs.Namespace,
+				"PropagationPolicy can only select resources in its own namespace"))
+		}
+	}
+
+	if spec.Failover != nil && spec.Failover.Application != nil && spec.Failover.Application.DecisionConditions.TolerationSeconds == nil {
+		// karmada apiserver defaults it before validation, yaml is validated before being sent to it
+		defaulted := spec.DeepCopy()
+		setDefaultTolerationSeconds(defaulted.Failover.Application)
+		spec = defaulted
+	}
+	allErrs = append(allErrs, validation.ValidatePropagationSpec(*spec)...)
+	return allErrs
+}
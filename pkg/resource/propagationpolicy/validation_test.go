@@ -0,0 +1,183 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package propagationpolicy
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+)
+
+func TestSetDefaultPropagationSpec(t *testing.T) {
+	cases := []struct {
+		name      string
+		namespace string
+		spec      v1alpha1.PropagationSpec
+		expected  v1alpha1.PropagationSpec
+	}{
+		{
+			name:      "namespace of selectors",
+			namespace: "default",
+			spec: v1alpha1.PropagationSpec{ResourceSelectors: []v1alpha1.ResourceSelector{
+				{APIVersion: "apps/v1", Kind: "Deployment"},
+				{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default"},
+			}},
+			expected: v1alpha1.PropagationSpec{ResourceSelectors: []v1alpha1.ResourceSelector{
+				{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default"},
+				{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default"},
+			}},
+		},
+		{
+			name: "cluster scope keeps selectors",
+			spec: v1alpha1.PropagationSpec{ResourceSelectors: []v1alpha1.ResourceSelector{
+				{APIVersion: "apps/v1", Kind: "Deployment"},
+			}},
+			expected: v1alpha1.PropagationSpec{ResourceSelectors: []v1alpha1.ResourceSelector{
+				{APIVersion: "apps/v1", Kind: "Deployment"},
+			}},
+		},
+		{
+			name: "spread constraints",
+			spec: v1alpha1.PropagationSpec{Placement: v1alpha1.Placement{
+				SpreadConstraints: []v1alpha1.SpreadConstraint{{MaxGroups: 2}},
+			}},
+			expected: v1alpha1.PropagationSpec{Placement: v1alpha1.Placement{
+				SpreadConstraints: []v1alpha1.SpreadConstraint{{SpreadByField: v1alpha1.SpreadByFieldCluster, MinGroups: 1, MaxGroups: 2}},
+			}},
+		},
+		{
+			name: "service import propagates dependencies",
+			spec: v1alpha1.PropagationSpec{ResourceSelectors: []v1alpha1.ResourceSelector{
+				{APIVersion: "multicluster.x-k8s.io/v1alpha1", Kind: "ServiceImport"},
+			}},
+			expected: v1alpha1.PropagationSpec{ResourceSelectors: []v1alpha1.ResourceSelector{
+				{APIVersion: "multicluster.x-k8s.io/v1alpha1", Kind: "ServiceImport"},
+			}, PropagateDeps: true},
+		},
+		{
+			name: "divided replicas are weighted",
+			spec: v1alpha1.PropagationSpec{Placement: v1alpha1.Placement{
+				ReplicaScheduling: &v1alpha1.ReplicaSchedulingStrategy{ReplicaSchedulingType: v1alpha1.ReplicaSchedulingTypeDivided},
+			}},
+			expected: v1alpha1.PropagationSpec{Placement: v1alpha1.Placement{
+				ReplicaScheduling: &v1alpha1.ReplicaSchedulingStrategy{
+					ReplicaSchedulingType:     v1alpha1.ReplicaSchedulingTypeDivided,
+					ReplicaDivisionPreference: v1alpha1.ReplicaDivisionPreferenceWeighted,
+				},
+			}},
+		},
+		{
+			name: "grace period of application failover",
+			spec: v1alpha1.PropagationSpec{Failover: &v1alpha1.FailoverBehavior{
+				Application: &v1alpha1.ApplicationFailoverBehavior{PurgeMode: v1alpha1.Graciously},
+			}},
+			expected: v1alpha1.PropagationSpec{Failover: &v1alpha1.FailoverBehavior{
+				Application: &v1alpha1.ApplicationFailoverBehavior{
+					DecisionConditions: v1alpha1.DecisionConditions{TolerationSeconds: ptr.To[int32](300)},
+					PurgeMode:          v1alpha1.Graciously,
+					GracePeriodSeconds: ptr.To[int32](600),
+				},
+			}},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			SetDefaultPropagationSpec(tc.namespace, &tc.spec)
+			if !reflect.DeepEqual(tc.spec, tc.expected) {
+				t.Errorf("SetDefaultPropagationSpec() = %+v, expected %+v", tc.spec, tc.expected)
+			}
+		})
+	}
+}
+
+func TestValidatePropagationPolicy(t *testing.T) {
+	deployments := []v1alpha1.ResourceSelector{{APIVersion: "apps/v1", Kind: "Deployment"}}
+	cases := []struct {
+		name      string
+		policy    string
+		namespace string
+		spec      v1alpha1.PropagationSpec
+		expected  []string
+	}{
+		{name: "valid", policy: "nginx", namespace: "default", spec: v1alpha1.PropagationSpec{ResourceSelectors: deployments}},
+		{name: "valid cluster scope", policy: "nginx", spec: v1alpha1.PropagationSpec{ResourceSelectors: deployments}},
+		{
+			name: "missing name", namespace: "default",
+			spec:     v1alpha1.PropagationSpec{ResourceSelectors: deployments},
+			expected: []string{"metadata.name"},
+		},
+		{
+			name: "invalid name", policy: "Nginx_1", namespace: "default",
+			spec:     v1alpha1.PropagationSpec{ResourceSelectors: deployments},
+			expected: []string{"metadata.name"},
+		},
+		{
+			name: "invalid namespace", policy: "nginx", namespace: "Default",
+			spec:     v1alpha1.PropagationSpec{ResourceSelectors: deployments},
+			expected: []string{"metadata.namespace"},
+		},
+		{
+			name: "no selector", policy: "nginx", namespace: "default",
+			expected: []string{"spec.resourceSelectors"},
+		},
+		{
+			name: "incomplete selector", policy: "nginx", namespace: "default",
+			spec:     v1alpha1.PropagationSpec{ResourceSelectors: []v1alpha1.ResourceSelector{{}}},
+			expected: []string{"spec.resourceSelectors[0].apiVersion", "spec.resourceSelectors[0].kind"},
+		},
+		{
+			name: "selector in another namespace", policy: "nginx", namespace: "default",
+			spec: v1alpha1.PropagationSpec{ResourceSelectors: []v1alpha1.ResourceSelector{
+				{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "kube-system"},
+			}},
+			expected: []string{"spec.resourceSelectors[0].namespace"},
+		},
+		{
+			name: "failover without dependencies", policy: "nginx", namespace: "default",
+			spec: v1alpha1.PropagationSpec{ResourceSelectors: deployments, Failover: &v1alpha1.FailoverBehavior{
+				Application: &v1alpha1.ApplicationFailoverBehavior{},
+			}},
+			expected: []string{"spec.propagateDeps"},
+		},
+		{
+			name: "invalid placement", policy: "nginx", namespace: "default",
+			spec: v1alpha1.PropagationSpec{ResourceSelectors: deployments, Placement: v1alpha1.Placement{
+				SpreadConstraints: []v1alpha1.SpreadConstraint{{SpreadByField: v1alpha1.SpreadByFieldCluster, MinGroups: 3, MaxGroups: 2}},
+			}},
+			expected: []string{"spec.placement.spreadConstraints[0]"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := fieldPaths(ValidatePropagationPolicy(tc.policy, tc.namespace, &tc.spec))
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("ValidatePropagationPolicy() = %v, expected %v", actual, tc.expected)
+			}
+		})
+	}
+}
+
+func fieldPaths(errs field.ErrorList) []string {
+	var paths []string
+	for _, err := range errs {
+		paths = append(paths, err.Field)
+	}
+	return paths
+}
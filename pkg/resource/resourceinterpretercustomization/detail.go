@@ -0,0 +1,50 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceinterpretercustomization
+
+import (
+	"context"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ResourceInterpreterCustomizationDetail is a presentation layer view of Karmada ResourceInterpreterCustomization resource.
+type ResourceInterpreterCustomizationDetail struct {
+	// Extends list item structure.
+	ResourceInterpreterCustomization `json:",inline"`
+
+	Customizations configv1alpha1.CustomizationRules `json:"customizations"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetResourceInterpreterCustomizationDetail gets ResourceInterpreterCustomization details.
+func GetResourceInterpreterCustomizationDetail(client karmadaclientset.Interface, name string) (*ResourceInterpreterCustomizationDetail, error) {
+	customization, err := client.ConfigV1alpha1().ResourceInterpreterCustomizations().Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResourceInterpreterCustomizationDetail{
+		ResourceInterpreterCustomization: toResourceInterpreterCustomization(customization),
+		Customizations:                   customization.Spec.Customizations,
+		Errors:                           []error{},
+	}, nil
+}
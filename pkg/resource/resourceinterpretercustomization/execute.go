@@ -0,0 +1,86 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceinterpretercustomization
+
+import (
+	"fmt"
+	"strings"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customized/declarative"
+	"github.com/karmada-io/karmada/pkg/util/interpreter"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ExecuteResult is the result of running an interpreter operation of a customization locally.
+type ExecuteResult struct {
+	Operation string `json:"operation"`
+	// Results are the named return values of the lua script, e.g. replica and requires for InterpretReplica.
+	Results []ExecuteOutput `json:"results"`
+	// Error is the error raised while running the lua script, it is reported as part of the result
+	// rather than as a request failure so that authors can iterate on their scripts.
+	Error string `json:"error,omitempty"`
+}
+
+// ExecuteOutput is a single named return value of an interpreter operation.
+type ExecuteOutput struct {
+	Name  string      `json:"name"`
+	Value interface{} `json:"value"`
+}
+
+// Operations returns the names of all operations that can be customized and executed.
+func Operations() []string {
+	return interpreter.Rules(interpreter.AllResourceInterpreterCustomizationRules).Names()
+}
+
+// Execute runs the lua script of the given operation in the customization against the sample objects
+// with karmada's configurable interpreter, nothing is read from or written to the control-plane.
+func Execute(customization *configv1alpha1.ResourceInterpreterCustomization, operation string, args interpreter.RuleArgs) (*ExecuteResult, error) {
+	rule := interpreter.Rules(interpreter.AllResourceInterpreterCustomizationRules).GetByOperation(operation)
+	if rule == nil {
+		return nil, fmt.Errorf("unknown operation %q, supported operations are %s", operation, strings.Join(Operations(), ", "))
+	}
+	if rule.GetScript(customization) == "" {
+		return nil, fmt.Errorf("operation %s is not customized by %q", rule.Name(), customization.Name)
+	}
+	for _, obj := range []*unstructured.Unstructured{args.Desired, args.Observed} {
+		if obj == nil {
+			continue
+		}
+		if obj.GetAPIVersion() != customization.Spec.Target.APIVersion || obj.GetKind() != customization.Spec.Target.Kind {
+			return nil, fmt.Errorf("object of %s %s does not match customization target %s %s",
+				obj.GetAPIVersion(), obj.GetKind(), customization.Spec.Target.APIVersion, customization.Spec.Target.Kind)
+		}
+	}
+
+	configurableInterpreter := declarative.NewConfigurableInterpreter(nil)
+	configurableInterpreter.LoadConfig([]*configv1alpha1.ResourceInterpreterCustomization{customization})
+
+	ruleResult := rule.Run(configurableInterpreter, args)
+	result := &ExecuteResult{
+		Operation: rule.Name(),
+		Results:   make([]ExecuteOutput, 0, len(ruleResult.Results)),
+	}
+	if ruleResult.Err != nil {
+		result.Error = ruleResult.Err.Error()
+		return result, nil
+	}
+	for _, r := range ruleResult.Results {
+		result.Results = append(result.Results, ExecuteOutput{Name: r.Name, Value: r.Value})
+	}
+	return result, nil
+}
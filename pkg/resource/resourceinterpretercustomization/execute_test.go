@@ -0,0 +1,111 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceinterpretercustomization
+
+import (
+	"reflect"
+	"testing"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/util/interpreter"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestExecute(t *testing.T) {
+	customization := &configv1alpha1.ResourceInterpreterCustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+		Spec: configv1alpha1.ResourceInterpreterCustomizationSpec{
+			Target: configv1alpha1.CustomizationTarget{APIVersion: "example.io/v1", Kind: "Foo"},
+			Customizations: configv1alpha1.CustomizationRules{
+				HealthInterpretation: &configv1alpha1.HealthInterpretation{
+					LuaScript: `
+function InterpretHealth(observedObj)
+  return observedObj.status.ready == true
+end`,
+				},
+			},
+		},
+	}
+	foo := func(apiVersion string, ready bool) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": apiVersion,
+			"kind":       "Foo",
+			"metadata":   map[string]interface{}{"name": "foo"},
+			"status":     map[string]interface{}{"ready": ready},
+		}}
+	}
+
+	cases := []struct {
+		name      string
+		operation string
+		args      interpreter.RuleArgs
+		want      *ExecuteResult
+		wantErr   bool
+	}{
+		{
+			name:      "healthy",
+			operation: "interprethealth",
+			args:      interpreter.RuleArgs{Observed: foo("example.io/v1", true)},
+			want:      &ExecuteResult{Operation: "InterpretHealth", Results: []ExecuteOutput{{Name: "healthy", Value: true}}},
+		},
+		{
+			name:      "unhealthy",
+			operation: "InterpretHealth",
+			args:      interpreter.RuleArgs{Observed: foo("example.io/v1", false)},
+			want:      &ExecuteResult{Operation: "InterpretHealth", Results: []ExecuteOutput{{Name: "healthy", Value: false}}},
+		},
+		{
+			name:      "object is required",
+			operation: "InterpretHealth",
+			want: &ExecuteResult{
+				Operation: "InterpretHealth",
+				Results:   []ExecuteOutput{},
+				Error:     "desired-file, observed-file options are not set",
+			},
+		},
+		{
+			name:      "operation not customized",
+			operation: "InterpretReplica",
+			args:      interpreter.RuleArgs{Observed: foo("example.io/v1", true)},
+			wantErr:   true,
+		},
+		{
+			name:      "unknown operation",
+			operation: "Foo",
+			args:      interpreter.RuleArgs{Observed: foo("example.io/v1", true)},
+			wantErr:   true,
+		},
+		{
+			name:      "target mismatch",
+			operation: "InterpretHealth",
+			args:      interpreter.RuleArgs{Observed: foo("example.io/v2", true)},
+			wantErr:   true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := Execute(customization, c.operation, c.args)
+			if (err != nil) != c.wantErr {
+				t.Fatalf("Execute() error = %v, wantErr %v", err, c.wantErr)
+			}
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("Execute() = %#v, want %#v", got, c.want)
+			}
+		})
+	}
+}
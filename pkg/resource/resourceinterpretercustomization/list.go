@@ -0,0 +1,98 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceinterpretercustomization
+
+import (
+	"context"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/util/interpreter"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+)
+
+// ResourceInterpreterCustomizationList contains a list of ResourceInterpreterCustomizations in the karmada control-plane.
+type ResourceInterpreterCustomizationList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+
+	// Unordered list of ResourceInterpreterCustomizations.
+	Items []ResourceInterpreterCustomization `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// ResourceInterpreterCustomization contains information about a single ResourceInterpreterCustomization.
+type ResourceInterpreterCustomization struct {
+	ObjectMeta types.ObjectMeta                   `json:"objectMeta"`
+	TypeMeta   types.TypeMeta                     `json:"typeMeta"`
+	Target     configv1alpha1.CustomizationTarget `json:"target"`
+	// Operations are the names of the interpreter operations customized by lua script.
+	Operations []string `json:"operations"`
+}
+
+// GetResourceInterpreterCustomizationList returns a list of all ResourceInterpreterCustomizations in the karmada control-plane.
+func GetResourceInterpreterCustomizationList(client karmadaclientset.Interface, dsQuery *dataselect.DataSelectQuery) (*ResourceInterpreterCustomizationList, error) {
+	customizations, err := client.ConfigV1alpha1().ResourceInterpreterCustomizations().List(context.TODO(), helpers.ListEverything)
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	return toResourceInterpreterCustomizationList(customizations.Items, nonCriticalErrors, dsQuery), nil
+}
+
+func toResourceInterpreterCustomizationList(customizations []configv1alpha1.ResourceInterpreterCustomization, nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery) *ResourceInterpreterCustomizationList {
+	result := &ResourceInterpreterCustomizationList{
+		Items:    make([]ResourceInterpreterCustomization, 0),
+		ListMeta: types.ListMeta{TotalItems: len(customizations)},
+		Errors:   nonCriticalErrors,
+	}
+
+	customizationCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(customizations), dsQuery)
+	customizations = fromCells(customizationCells)
+	result.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+
+	for i := range customizations {
+		result.Items = append(result.Items, toResourceInterpreterCustomization(&customizations[i]))
+	}
+	return result
+}
+
+func toResourceInterpreterCustomization(customization *configv1alpha1.ResourceInterpreterCustomization) ResourceInterpreterCustomization {
+	return ResourceInterpreterCustomization{
+		ObjectMeta: types.NewObjectMeta(customization.ObjectMeta),
+		TypeMeta:   types.NewTypeMeta(types.ResourceKindResourceInterpreterCustomization),
+		Target:     customization.Spec.Target,
+		Operations: customizedOperations(customization),
+	}
+}
+
+// customizedOperations returns the names of the operations that have a lua script in the customization.
+func customizedOperations(customization *configv1alpha1.ResourceInterpreterCustomization) []string {
+	operations := make([]string, 0)
+	for _, rule := range interpreter.AllResourceInterpreterCustomizationRules {
+		if rule.GetScript(customization) != "" {
+			operations = append(operations, rule.Name())
+		}
+	}
+	return operations
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceinterpretercustomization
+
+import (
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateResourceInterpreterCustomization validates the name and spec of ResourceInterpreterCustomization,
+// the customization is only picked up by karmada when its target names a resource type.
+func ValidateResourceInterpreterCustomization(name string, spec *configv1alpha1.ResourceInterpreterCustomizationSpec) field.ErrorList {
+	var allErrs field.ErrorList
+	namePath := field.NewPath("metadata").Child("name")
+	if name == "" {
+		allErrs = append(allErrs, field.Required(namePath, ""))
+	} else {
+		for _, msg := range apimachineryvalidation.NameIsDNSSubdomain(name, false) {
+			allErrs = append(allErrs, field.Invalid(namePath, name, msg))
+		}
+	}
+
+	targetPath := field.NewPath("spec").Child("target")
+	if spec.Target.APIVersion == "" {
+		allErrs = append(allErrs, field.Required(targetPath.Child("apiVersion"), ""))
+	} else if _, err := schema.ParseGroupVersion(spec.Target.APIVersion); err != nil {
+		allErrs = append(allErrs, field.Invalid(targetPath.Child("apiVersion"), spec.Target.APIVersion, err.Error()))
+	}
+	if spec.Target.Kind == "" {
+		allErrs = append(allErrs, field.Required(targetPath.Child("kind"), ""))
+	}
+	return allErrs
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceinterpretercustomization
+
+import (
+	"testing"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+)
+
+func TestValidateResourceInterpreterCustomization(t *testing.T) {
+	cases := []struct {
+		name          string
+		customization string
+		target        configv1alpha1.CustomizationTarget
+		wantErrs      int
+	}{
+		{name: "valid", customization: "foo", target: configv1alpha1.CustomizationTarget{APIVersion: "example.io/v1", Kind: "Foo"}},
+		{name: "missing name", target: configv1alpha1.CustomizationTarget{APIVersion: "example.io/v1", Kind: "Foo"}, wantErrs: 1},
+		{name: "invalid name", customization: "Foo_bar", target: configv1alpha1.CustomizationTarget{APIVersion: "example.io/v1", Kind: "Foo"}, wantErrs: 1},
+		{name: "missing target", customization: "foo", wantErrs: 2},
+		{name: "missing kind", customization: "foo", target: configv1alpha1.CustomizationTarget{APIVersion: "example.io/v1"}, wantErrs: 1},
+		{name: "invalid apiVersion", customization: "foo", target: configv1alpha1.CustomizationTarget{APIVersion: "a/b/c", Kind: "Foo"}, wantErrs: 1},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidateResourceInterpreterCustomization(tc.customization, &configv1alpha1.ResourceInterpreterCustomizationSpec{Target: tc.target})
+			if len(errs) != tc.wantErrs {
+				t.Errorf("ValidateResourceInterpreterCustomization() = %v, want %d errors", errs, tc.wantErrs)
+			}
+		})
+	}
+}
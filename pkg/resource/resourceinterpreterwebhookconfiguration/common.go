@@ -0,0 +1,55 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceinterpreterwebhookconfiguration
+
+import (
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+)
+
+// ResourceInterpreterWebhookConfigurationCell wraps configv1alpha1.ResourceInterpreterWebhookConfiguration for data selection.
+type ResourceInterpreterWebhookConfigurationCell configv1alpha1.ResourceInterpreterWebhookConfiguration
+
+// GetProperty returns a property of the resource interpreter webhook configuration cell.
+func (c ResourceInterpreterWebhookConfigurationCell) GetProperty(name dataselect.PropertyName) dataselect.ComparableValue {
+	switch name {
+	case dataselect.NameProperty:
+		return dataselect.StdComparableString(c.ObjectMeta.Name)
+	case dataselect.CreationTimestampProperty:
+		return dataselect.StdComparableTime(c.ObjectMeta.CreationTimestamp.Time)
+	default:
+		// if name is not supported then just return a constant dummy value, sort will have no effect.
+		return nil
+	}
+}
+
+func toCells(std []configv1alpha1.ResourceInterpreterWebhookConfiguration) []dataselect.DataCell {
+	cells := make([]dataselect.DataCell, len(std))
+	for i := range std {
+		cells[i] = ResourceInterpreterWebhookConfigurationCell(std[i])
+	}
+	return cells
+}
+
+func fromCells(cells []dataselect.DataCell) []configv1alpha1.ResourceInterpreterWebhookConfiguration {
+	std := make([]configv1alpha1.ResourceInterpreterWebhookConfiguration, len(cells))
+	for i := range std {
+		std[i] = configv1alpha1.ResourceInterpreterWebhookConfiguration(cells[i].(ResourceInterpreterWebhookConfigurationCell))
+	}
+	return std
+}
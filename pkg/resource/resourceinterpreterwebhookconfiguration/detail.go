@@ -0,0 +1,50 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceinterpreterwebhookconfiguration
+
+import (
+	"context"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ResourceInterpreterWebhookConfigurationDetail is a presentation layer view of Karmada ResourceInterpreterWebhookConfiguration resource.
+type ResourceInterpreterWebhookConfigurationDetail struct {
+	// Extends list item structure.
+	ResourceInterpreterWebhookConfiguration `json:",inline"`
+
+	Webhooks []configv1alpha1.ResourceInterpreterWebhook `json:"webhooks"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetResourceInterpreterWebhookConfigurationDetail gets ResourceInterpreterWebhookConfiguration details.
+func GetResourceInterpreterWebhookConfigurationDetail(client karmadaclientset.Interface, name string) (*ResourceInterpreterWebhookConfigurationDetail, error) {
+	configuration, err := client.ConfigV1alpha1().ResourceInterpreterWebhookConfigurations().Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResourceInterpreterWebhookConfigurationDetail{
+		ResourceInterpreterWebhookConfiguration: toResourceInterpreterWebhookConfiguration(configuration),
+		Webhooks:                                configuration.Webhooks,
+		Errors:                                  []error{},
+	}, nil
+}
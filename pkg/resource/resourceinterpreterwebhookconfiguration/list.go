@@ -0,0 +1,88 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceinterpreterwebhookconfiguration
+
+import (
+	"context"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+)
+
+// ResourceInterpreterWebhookConfigurationList contains a list of ResourceInterpreterWebhookConfigurations in the karmada control-plane.
+type ResourceInterpreterWebhookConfigurationList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+
+	// Unordered list of ResourceInterpreterWebhookConfigurations.
+	Items []ResourceInterpreterWebhookConfiguration `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// ResourceInterpreterWebhookConfiguration contains information about a single ResourceInterpreterWebhookConfiguration.
+type ResourceInterpreterWebhookConfiguration struct {
+	ObjectMeta types.ObjectMeta `json:"objectMeta"`
+	TypeMeta   types.TypeMeta   `json:"typeMeta"`
+	// WebhookNames are the names of the webhooks in the configuration.
+	WebhookNames []string `json:"webhookNames"`
+}
+
+// GetResourceInterpreterWebhookConfigurationList returns a list of all ResourceInterpreterWebhookConfigurations in the karmada control-plane.
+func GetResourceInterpreterWebhookConfigurationList(client karmadaclientset.Interface, dsQuery *dataselect.DataSelectQuery) (*ResourceInterpreterWebhookConfigurationList, error) {
+	configurations, err := client.ConfigV1alpha1().ResourceInterpreterWebhookConfigurations().List(context.TODO(), helpers.ListEverything)
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	return toResourceInterpreterWebhookConfigurationList(configurations.Items, nonCriticalErrors, dsQuery), nil
+}
+
+func toResourceInterpreterWebhookConfigurationList(configurations []configv1alpha1.ResourceInterpreterWebhookConfiguration, nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery) *ResourceInterpreterWebhookConfigurationList {
+	result := &ResourceInterpreterWebhookConfigurationList{
+		Items:    make([]ResourceInterpreterWebhookConfiguration, 0),
+		ListMeta: types.ListMeta{TotalItems: len(configurations)},
+		Errors:   nonCriticalErrors,
+	}
+
+	configurationCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(configurations), dsQuery)
+	configurations = fromCells(configurationCells)
+	result.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+
+	for i := range configurations {
+		result.Items = append(result.Items, toResourceInterpreterWebhookConfiguration(&configurations[i]))
+	}
+	return result
+}
+
+func toResourceInterpreterWebhookConfiguration(configuration *configv1alpha1.ResourceInterpreterWebhookConfiguration) ResourceInterpreterWebhookConfiguration {
+	webhookNames := make([]string, 0, len(configuration.Webhooks))
+	for _, hook := range configuration.Webhooks {
+		webhookNames = append(webhookNames, hook.Name)
+	}
+	return ResourceInterpreterWebhookConfiguration{
+		ObjectMeta:   types.NewObjectMeta(configuration.ObjectMeta),
+		TypeMeta:     types.NewTypeMeta(types.ResourceKindResourceInterpreterWebhookConfiguration),
+		WebhookNames: webhookNames,
+	}
+}
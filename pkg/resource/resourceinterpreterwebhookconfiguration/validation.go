@@ -0,0 +1,60 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceinterpreterwebhookconfiguration
+
+import (
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateResourceInterpreterWebhookConfiguration validates the name and webhooks of
+// ResourceInterpreterWebhookConfiguration, the rules and client configs are left to karmada-webhook.
+func ValidateResourceInterpreterWebhookConfiguration(name string, webhooks []configv1alpha1.ResourceInterpreterWebhook) field.ErrorList {
+	var allErrs field.ErrorList
+	namePath := field.NewPath("metadata").Child("name")
+	if name == "" {
+		allErrs = append(allErrs, field.Required(namePath, ""))
+	} else {
+		for _, msg := range apimachineryvalidation.NameIsDNSSubdomain(name, false) {
+			allErrs = append(allErrs, field.Invalid(namePath, name, msg))
+		}
+	}
+
+	webhooksPath := field.NewPath("webhooks")
+	if len(webhooks) == 0 {
+		allErrs = append(allErrs, field.Required(webhooksPath, "at least one webhook is required"))
+	}
+	hookNames := sets.New[string]()
+	for i, hook := range webhooks {
+		hookPath := webhooksPath.Index(i)
+		if hook.Name == "" {
+			allErrs = append(allErrs, field.Required(hookPath.Child("name"), ""))
+		} else if hookNames.Has(hook.Name) {
+			allErrs = append(allErrs, field.Duplicate(hookPath.Child("name"), hook.Name))
+		}
+		hookNames.Insert(hook.Name)
+		if hook.ClientConfig.URL == nil && hook.ClientConfig.Service == nil {
+			allErrs = append(allErrs, field.Required(hookPath.Child("clientConfig"), "exactly one of url or service is required"))
+		}
+		if len(hook.InterpreterContextVersions) == 0 {
+			allErrs = append(allErrs, field.Required(hookPath.Child("interpreterContextVersions"), ""))
+		}
+	}
+	return allErrs
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceinterpreterwebhookconfiguration
+
+import (
+	"testing"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+)
+
+func TestValidateResourceInterpreterWebhookConfiguration(t *testing.T) {
+	url := "https://interpreter.example.io/interpret"
+	hook := func(name string) configv1alpha1.ResourceInterpreterWebhook {
+		return configv1alpha1.ResourceInterpreterWebhook{
+			Name:                       name,
+			ClientConfig:               admissionregistrationv1.WebhookClientConfig{URL: &url},
+			InterpreterContextVersions: []string{"v1alpha1"},
+		}
+	}
+	cases := []struct {
+		name          string
+		configuration string
+		webhooks      []configv1alpha1.ResourceInterpreterWebhook
+		wantErrs      int
+	}{
+		{name: "valid", configuration: "examples", webhooks: []configv1alpha1.ResourceInterpreterWebhook{hook("foo.example.io"), hook("bar.example.io")}},
+		{name: "missing name", webhooks: []configv1alpha1.ResourceInterpreterWebhook{hook("foo.example.io")}, wantErrs: 1},
+		{name: "no webhook", configuration: "examples", wantErrs: 1},
+		{name: "incomplete webhook", configuration: "examples", webhooks: []configv1alpha1.ResourceInterpreterWebhook{{}}, wantErrs: 3},
+		{name: "duplicate webhook", configuration: "examples", webhooks: []configv1alpha1.ResourceInterpreterWebhook{hook("foo.example.io"), hook("foo.example.io")}, wantErrs: 1},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidateResourceInterpreterWebhookConfiguration(tc.configuration, tc.webhooks)
+			if len(errs) != tc.wantErrs {
+				t.Errorf("ValidateResourceInterpreterWebhookConfiguration() = %v, want %d errors", errs, tc.wantErrs)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceregistry
+
+import (
+	"context"
+
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ResourceRegistryDetail is a presentation layer view of Karmada ResourceRegistry resource.
+type ResourceRegistryDetail struct {
+	// Extends list item structure.
+	ResourceRegistry `json:",inline"`
+
+	Conditions []metaV1.Condition `json:"conditions"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetResourceRegistryDetail gets ResourceRegistry details.
+func GetResourceRegistryDetail(client karmadaclientset.Interface, name string) (*ResourceRegistryDetail, error) {
+	registry, err := client.SearchV1alpha1().ResourceRegistries().Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResourceRegistryDetail{
+		ResourceRegistry: toResourceRegistry(registry),
+		Conditions:       registry.Status.Conditions,
+		Errors:           []error{},
+	}, nil
+}
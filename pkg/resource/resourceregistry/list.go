@@ -0,0 +1,94 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceregistry
+
+import (
+	"context"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	searchv1alpha1 "github.com/karmada-io/karmada/pkg/apis/search/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+)
+
+// ResourceRegistryList contains a list of ResourceRegistries in the karmada control-plane.
+type ResourceRegistryList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+
+	// Unordered list of ResourceRegistries.
+	Items []ResourceRegistry `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// ResourceRegistry contains information about a single ResourceRegistry, it chooses the resources
+// karmada-search caches from the member clusters.
+type ResourceRegistry struct {
+	ObjectMeta        types.ObjectMeta                  `json:"objectMeta"`
+	TypeMeta          types.TypeMeta                    `json:"typeMeta"`
+	TargetCluster     policyv1alpha1.ClusterAffinity    `json:"targetCluster"`
+	ResourceSelectors []searchv1alpha1.ResourceSelector `json:"resourceSelectors"`
+	// BackendStore is empty when the resources are cached in memory.
+	BackendStore string `json:"backendStore"`
+}
+
+// GetResourceRegistryList returns a list of all ResourceRegistries in the karmada control-plane.
+func GetResourceRegistryList(client karmadaclientset.Interface, dsQuery *dataselect.DataSelectQuery) (*ResourceRegistryList, error) {
+	registries, err := client.SearchV1alpha1().ResourceRegistries().List(context.TODO(), helpers.ListEverything)
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	return toResourceRegistryList(registries.Items, nonCriticalErrors, dsQuery), nil
+}
+
+func toResourceRegistryList(registries []searchv1alpha1.ResourceRegistry, nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery) *ResourceRegistryList {
+	result := &ResourceRegistryList{
+		Items:    make([]ResourceRegistry, 0),
+		ListMeta: types.ListMeta{TotalItems: len(registries)},
+		Errors:   nonCriticalErrors,
+	}
+
+	registryCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(registries), dsQuery)
+	registries = fromCells(registryCells)
+	result.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+
+	for i := range registries {
+		result.Items = append(result.Items, toResourceRegistry(&registries[i]))
+	}
+	return result
+}
+
+func toResourceRegistry(registry *searchv1alpha1.ResourceRegistry) ResourceRegistry {
+	backendStore := ""
+	if registry.Spec.BackendStore != nil && registry.Spec.BackendStore.OpenSearch != nil {
+		backendStore = "OpenSearch"
+	}
+	return ResourceRegistry{
+		ObjectMeta:        types.NewObjectMeta(registry.ObjectMeta),
+		TypeMeta:          types.NewTypeMeta(types.ResourceKindResourceRegistry),
+		TargetCluster:     registry.Spec.TargetCluster,
+		ResourceSelectors: registry.Spec.ResourceSelectors,
+		BackendStore:      backendStore,
+	}
+}
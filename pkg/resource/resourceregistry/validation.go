@@ -0,0 +1,59 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceregistry
+
+import (
+	searchv1alpha1 "github.com/karmada-io/karmada/pkg/apis/search/v1alpha1"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateResourceRegistry validates the name and spec of ResourceRegistry, karmada-search can't cache a
+// resource which is selected twice.
+func ValidateResourceRegistry(name string, spec *searchv1alpha1.ResourceRegistrySpec) field.ErrorList {
+	var allErrs field.ErrorList
+	namePath := field.NewPath("metadata").Child("name")
+	if name == "" {
+		allErrs = append(allErrs, field.Required(namePath, ""))
+	} else {
+		for _, msg := range apimachineryvalidation.NameIsDNSSubdomain(name, false) {
+			allErrs = append(allErrs, field.Invalid(namePath, name, msg))
+		}
+	}
+
+	selectorsPath := field.NewPath("spec").Child("resourceSelectors")
+	if len(spec.ResourceSelectors) == 0 {
+		allErrs = append(allErrs, field.Required(selectorsPath, "at least one resource selector is required"))
+	}
+	selected := map[searchv1alpha1.ResourceSelector]bool{}
+	for i, rs := range spec.ResourceSelectors {
+		if rs.APIVersion == "" {
+			allErrs = append(allErrs, field.Required(selectorsPath.Index(i).Child("apiVersion"), ""))
+		} else if _, err := schema.ParseGroupVersion(rs.APIVersion); err != nil {
+			allErrs = append(allErrs, field.Invalid(selectorsPath.Index(i).Child("apiVersion"), rs.APIVersion, err.Error()))
+		}
+		if rs.Kind == "" {
+			allErrs = append(allErrs, field.Required(selectorsPath.Index(i).Child("kind"), ""))
+		}
+		if selected[rs] {
+			allErrs = append(allErrs, field.Duplicate(selectorsPath.Index(i), rs))
+		}
+		selected[rs] = true
+	}
+	return allErrs
+}
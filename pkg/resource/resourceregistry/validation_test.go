@@ -0,0 +1,48 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceregistry
+
+import (
+	"testing"
+
+	searchv1alpha1 "github.com/karmada-io/karmada/pkg/apis/search/v1alpha1"
+)
+
+func TestValidateResourceRegistry(t *testing.T) {
+	pods := searchv1alpha1.ResourceSelector{APIVersion: "v1", Kind: "Pod"}
+	cases := []struct {
+		name      string
+		registry  string
+		selectors []searchv1alpha1.ResourceSelector
+		wantErrs  int
+	}{
+		{name: "valid", registry: "cache", selectors: []searchv1alpha1.ResourceSelector{pods, {APIVersion: "apps/v1", Kind: "Deployment"}}},
+		{name: "missing name", selectors: []searchv1alpha1.ResourceSelector{pods}, wantErrs: 1},
+		{name: "no selector", registry: "cache", wantErrs: 1},
+		{name: "incomplete selector", registry: "cache", selectors: []searchv1alpha1.ResourceSelector{{}}, wantErrs: 2},
+		{name: "invalid apiVersion", registry: "cache", selectors: []searchv1alpha1.ResourceSelector{{APIVersion: "a/b/c", Kind: "Pod"}}, wantErrs: 1},
+		{name: "duplicate selector", registry: "cache", selectors: []searchv1alpha1.ResourceSelector{pods, pods}, wantErrs: 1},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidateResourceRegistry(tc.registry, &searchv1alpha1.ResourceRegistrySpec{ResourceSelectors: tc.selectors})
+			if len(errs) != tc.wantErrs {
+				t.Errorf("ValidateResourceRegistry() = %v, want %d errors", errs, tc.wantErrs)
+			}
+		})
+	}
+}
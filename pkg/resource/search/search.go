@@ -0,0 +1,384 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	searchv1alpha1 "github.com/karmada-io/karmada/pkg/apis/search/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/rest"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+)
+
+const (
+	// DefaultTimeout is how long a search waits for the clusters when the query has no timeout.
+	DefaultTimeout = 10 * time.Second
+	// MaxTimeout is the longest a search may wait for the clusters.
+	MaxTimeout = time.Minute
+	// DefaultLimit is the number of items returned when the query has no limit.
+	DefaultLimit = 500
+)
+
+// searchKinds maps the kinds that can be searched to their resource.
+var searchKinds = map[types.ResourceKind]schema.GroupVersionResource{
+	types.ResourceKindDeployment:  {Group: "apps", Version: "v1", Resource: "deployments"},
+	types.ResourceKindStatefulSet: {Group: "apps", Version: "v1", Resource: "statefulsets"},
+	types.ResourceKindDaemonSet:   {Group: "apps", Version: "v1", Resource: "daemonsets"},
+	types.ResourceKindJob:         {Group: "batch", Version: "v1", Resource: "jobs"},
+	types.ResourceKindCronJob:     {Group: "batch", Version: "v1", Resource: "cronjobs"},
+	types.ResourceKindPod:         {Version: "v1", Resource: "pods"},
+	types.ResourceKindService:     {Version: "v1", Resource: "services"},
+	types.ResourceKindIngress:     {Group: "networking.k8s.io", Version: "v1", Resource: "ingresses"},
+	types.ResourceKindConfigMap:   {Version: "v1", Resource: "configmaps"},
+	types.ResourceKindSecret:      {Version: "v1", Resource: "secrets"},
+	types.ResourceKindNamespace:   {Version: "v1", Resource: "namespaces"},
+}
+
+// Target is a cluster searched, the control plane or a member cluster.
+type Target struct {
+	// Cluster is empty for the karmada control plane.
+	Cluster string
+	Client  metadata.Interface
+}
+
+// Query describes the resources searched for.
+type Query struct {
+	// Name matches the resources whose name contains it, case insensitively.
+	Name          string
+	LabelSelector string
+	// Kinds defaults to all the kinds that can be searched.
+	Kinds     []types.ResourceKind
+	Namespace string
+	Limit     int
+	Timeout   time.Duration
+}
+
+// Item is a resource found by a search.
+type Item struct {
+	// Cluster is empty for the karmada control plane.
+	Cluster           string            `json:"cluster"`
+	Namespace         string            `json:"namespace,omitempty"`
+	Name              string            `json:"name"`
+	Labels            map[string]string `json:"labels,omitempty"`
+	CreationTimestamp metav1.Time       `json:"creationTimestamp"`
+}
+
+// Group is the resources of a kind found by a search.
+type Group struct {
+	Kind  types.ResourceKind `json:"kind"`
+	Items []Item             `json:"items"`
+}
+
+// Failure is a cluster that could not be searched for a kind.
+type Failure struct {
+	Cluster string             `json:"cluster"`
+	Kind    types.ResourceKind `json:"kind"`
+	Error   string             `json:"error"`
+}
+
+// Result is the outcome of a search, the resources found are grouped by kind.
+type Result struct {
+	Groups     []Group `json:"groups"`
+	TotalItems int     `json:"totalItems"`
+	// Truncated is set when more resources than the limit were found.
+	Truncated bool      `json:"truncated"`
+	Failures  []Failure `json:"failures"`
+}
+
+// Kinds returns the kinds that can be searched.
+func Kinds() []types.ResourceKind {
+	kinds := make([]types.ResourceKind, 0, len(searchKinds))
+	for kind := range searchKinds {
+		kinds = append(kinds, kind)
+	}
+	sort.Slice(kinds, func(i, j int) bool { return kinds[i] < kinds[j] })
+	return kinds
+}
+
+// Cache is the cache of karmada-search, the kinds it caches are listed through its proxy for all the member
+// clusters in one call instead of from every member cluster.
+type Cache struct {
+	// Client sends requests to the proxy of karmada-search.
+	Client rest.Interface
+	// Clusters are the member clusters the resources of each kind are cached from.
+	Clusters map[types.ResourceKind]sets.Set[string]
+}
+
+// NewCache returns the cache of the kinds that the registries select in the namespace, all the namespaces
+// when it's empty, for the clusters they target.
+func NewCache(client rest.Interface, registries []searchv1alpha1.ResourceRegistry, clusters []clusterv1alpha1.Cluster, namespace string) *Cache {
+	cache := &Cache{Client: client, Clusters: make(map[types.ResourceKind]sets.Set[string])}
+	for _, registry := range registries {
+		var targets []string
+		for i := range clusters {
+			if util.ClusterMatches(&clusters[i], registry.Spec.TargetCluster) {
+				targets = append(targets, clusters[i].Name)
+			}
+		}
+		for kind, gvr := range searchKinds {
+			for _, selector := range registry.Spec.ResourceSelectors {
+				if selector.APIVersion != gvr.GroupVersion().String() || !strings.EqualFold(selector.Kind, string(kind)) {
+					continue
+				}
+				// a namespace selector restricts the cache of namespaced kinds to the namespace
+				if kind != types.ResourceKindNamespace && selector.Namespace != "" && selector.Namespace != namespace {
+					continue
+				}
+				if cache.Clusters[kind] == nil {
+					cache.Clusters[kind] = sets.New[string]()
+				}
+				cache.Clusters[kind].Insert(targets...)
+			}
+		}
+	}
+	return cache
+}
+
+// job lists a kind in one or more clusters, the clusters of the cache are listed by a single job.
+type job struct {
+	clusters []string
+	kind     types.ResourceKind
+	list     func(ctx context.Context) ([]Item, error)
+}
+
+// Search lists the kinds of the query in all the targets in parallel and returns the resources matching
+// it. The kinds cached by karmada-search are listed from the cache when it's not nil. A target failing or
+// not answering before the timeout of the query is reported as a failure, the resources found elsewhere
+// are returned anyway.
+func Search(ctx context.Context, targets []Target, cache *Cache, query Query) (*Result, error) {
+	kinds := query.Kinds
+	if len(kinds) == 0 {
+		kinds = Kinds()
+	}
+	for _, kind := range kinds {
+		if _, ok := searchKinds[kind]; !ok {
+			return nil, errors.NewBadRequest(fmt.Sprintf("%s can not be searched", kind))
+		}
+	}
+	timeout := query.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	} else if timeout > MaxTimeout {
+		timeout = MaxTimeout
+	}
+	limit := query.Limit
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+
+	jobs := make([]job, 0, len(targets)*len(kinds))
+	for _, kind := range kinds {
+		cached := sets.New[string]()
+		if cache != nil {
+			for _, target := range targets {
+				if target.Cluster != "" && cache.Clusters[kind].Has(target.Cluster) {
+					cached.Insert(target.Cluster)
+				}
+			}
+		}
+		if cached.Len() > 0 {
+			jobs = append(jobs, job{clusters: sets.List(cached), kind: kind, list: func(ctx context.Context) ([]Item, error) {
+				return searchCache(ctx, cache.Client, cached, kind, query)
+			}})
+		}
+		for _, target := range targets {
+			if cached.Has(target.Cluster) {
+				continue
+			}
+			jobs = append(jobs, job{clusters: []string{target.Cluster}, kind: kind, list: func(ctx context.Context) ([]Item, error) {
+				return searchTarget(ctx, target, kind, query)
+			}})
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	type answer struct {
+		job   int
+		items []Item
+		err   error
+	}
+	answers := make(chan answer)
+	var wg sync.WaitGroup
+	for i := range jobs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			items, err := jobs[i].list(ctx)
+			select {
+			case answers <- answer{job: i, items: items, err: err}:
+			case <-ctx.Done():
+			}
+		}(i)
+	}
+	go func() {
+		wg.Wait()
+		close(answers)
+	}()
+
+	pending := sets.New[int]()
+	for i := range jobs {
+		pending.Insert(i)
+	}
+	found := make(map[types.ResourceKind][]Item)
+	result := &Result{Groups: []Group{}, Failures: []Failure{}}
+	fail := func(j job, err string) {
+		for _, cluster := range j.clusters {
+			result.Failures = append(result.Failures, Failure{Cluster: cluster, Kind: j.kind, Error: err})
+		}
+	}
+loop:
+	for {
+		select {
+		case a, ok := <-answers:
+			if !ok {
+				break loop
+			}
+			pending.Delete(a.job)
+			if a.err != nil {
+				fail(jobs[a.job], a.err.Error())
+				continue
+			}
+			found[jobs[a.job].kind] = append(found[jobs[a.job].kind], a.items...)
+		case <-ctx.Done():
+			break loop
+		}
+	}
+	for i := range pending {
+		fail(jobs[i], fmt.Sprintf("no answer within %s", timeout))
+	}
+	sort.Slice(result.Failures, func(i, j int) bool {
+		if result.Failures[i].Cluster != result.Failures[j].Cluster {
+			return result.Failures[i].Cluster < result.Failures[j].Cluster
+		}
+		return result.Failures[i].Kind < result.Failures[j].Kind
+	})
+
+	for _, kind := range kinds {
+		items := found[kind]
+		if len(items) == 0 {
+			continue
+		}
+		sort.Slice(items, func(i, j int) bool {
+			if items[i].Cluster != items[j].Cluster {
+				return items[i].Cluster < items[j].Cluster
+			}
+			if items[i].Namespace != items[j].Namespace {
+				return items[i].Namespace < items[j].Namespace
+			}
+			return items[i].Name < items[j].Name
+		})
+		if remaining := limit - result.TotalItems; len(items) > remaining {
+			items = items[:remaining]
+			result.Truncated = true
+		}
+		if len(items) == 0 {
+			continue
+		}
+		result.TotalItems += len(items)
+		result.Groups = append(result.Groups, Group{Kind: kind, Items: items})
+	}
+	return result, nil
+}
+
+func searchTarget(ctx context.Context, target Target, kind types.ResourceKind, query Query) ([]Item, error) {
+	if target.Client == nil {
+		return nil, fmt.Errorf("no client for cluster %q", target.Cluster)
+	}
+	resource := target.Client.Resource(searchKinds[kind])
+	opts := metav1.ListOptions{LabelSelector: query.LabelSelector}
+	var list *metav1.PartialObjectMetadataList
+	var err error
+	if kind == types.ResourceKindNamespace || query.Namespace == "" {
+		list, err = resource.List(ctx, opts)
+	} else {
+		list, err = resource.Namespace(query.Namespace).List(ctx, opts)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toItems(list.Items, query, func(*metav1.PartialObjectMetadata) string { return target.Cluster }), nil
+}
+
+// searchCache lists a kind in the cache of karmada-search, the items are attributed to the member cluster
+// they are cached from and only the ones of the clusters are returned.
+func searchCache(ctx context.Context, client rest.Interface, clusters sets.Set[string], kind types.ResourceKind, query Query) ([]Item, error) {
+	gvr := searchKinds[kind]
+	prefix := []string{"/apis", gvr.Group, gvr.Version}
+	if gvr.Group == "" {
+		prefix = []string{"/api", gvr.Version}
+	}
+	request := client.Get().AbsPath(prefix...).Resource(gvr.Resource)
+	if kind != types.ResourceKindNamespace {
+		request = request.Namespace(query.Namespace)
+	}
+	if query.LabelSelector != "" {
+		request = request.Param("labelSelector", query.LabelSelector)
+	}
+	body, err := request.DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list := &metav1.PartialObjectMetadataList{}
+	if err = json.Unmarshal(body, list); err != nil {
+		return nil, err
+	}
+
+	cachedFrom := func(obj *metav1.PartialObjectMetadata) string {
+		return obj.Annotations[clusterv1alpha1.CacheSourceAnnotationKey]
+	}
+	items := make([]Item, 0)
+	for _, item := range toItems(list.Items, query, cachedFrom) {
+		if clusters.Has(item.Cluster) {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+func toItems(objects []metav1.PartialObjectMetadata, query Query, cluster func(*metav1.PartialObjectMetadata) string) []Item {
+	name := strings.ToLower(query.Name)
+	items := make([]Item, 0)
+	for i := range objects {
+		obj := &objects[i]
+		if !strings.Contains(strings.ToLower(obj.Name), name) {
+			continue
+		}
+		items = append(items, Item{
+			Cluster:           cluster(obj),
+			Namespace:         obj.Namespace,
+			Name:              obj.Name,
+			Labels:            obj.Labels,
+			CreationTimestamp: obj.CreationTimestamp,
+		})
+	}
+	return items
+}
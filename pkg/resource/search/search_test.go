@@ -0,0 +1,245 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	searchv1alpha1 "github.com/karmada-io/karmada/pkg/apis/search/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/metadata/fake"
+	restfake "k8s.io/client-go/rest/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/karmada-io/dashboard/pkg/common/types"
+)
+
+func newMetadata(apiVersion, kind, namespace, name string, labels map[string]string) *metav1.PartialObjectMetadata {
+	return &metav1.PartialObjectMetadata{
+		TypeMeta:   metav1.TypeMeta{APIVersion: apiVersion, Kind: kind},
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Labels: labels},
+	}
+}
+
+func newClient(objects ...runtime.Object) *fake.FakeMetadataClient {
+	scheme := fake.NewTestScheme()
+	metav1.AddMetaToScheme(scheme)
+	return fake.NewSimpleMetadataClient(scheme, objects...)
+}
+
+func TestSearch(t *testing.T) {
+	controlPlane := newClient(
+		newMetadata("apps/v1", "Deployment", "default", "nginx", map[string]string{"app": "nginx"}),
+		newMetadata("v1", "ConfigMap", "default", "nginx-config", nil),
+	)
+	member1 := newClient(
+		newMetadata("apps/v1", "Deployment", "default", "nginx", map[string]string{"app": "nginx"}),
+		newMetadata("v1", "Pod", "default", "nginx-6d4cf56db6-x2x7k", map[string]string{"app": "nginx"}),
+		newMetadata("v1", "Pod", "default", "redis-0", map[string]string{"app": "redis"}),
+	)
+	member2 := newClient()
+	member2.PrependReactor("list", "*", func(clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, fmt.Errorf("cluster is unreachable")
+	})
+	targets := []Target{{Client: controlPlane}, {Cluster: "member1", Client: member1}, {Cluster: "member2", Client: member2}}
+
+	cases := []struct {
+		name          string
+		query         Query
+		want          map[types.ResourceKind][]string
+		wantFailures  int
+		wantTruncated bool
+	}{
+		{
+			name:  "by name",
+			query: Query{Name: "NGINX", Kinds: []types.ResourceKind{types.ResourceKindDeployment, types.ResourceKindPod, types.ResourceKindConfigMap}},
+			want: map[types.ResourceKind][]string{
+				types.ResourceKindDeployment: {"/nginx", "member1/nginx"},
+				types.ResourceKindPod:        {"member1/nginx-6d4cf56db6-x2x7k"},
+				types.ResourceKindConfigMap:  {"/nginx-config"},
+			},
+			wantFailures: 3,
+		},
+		{
+			name:  "by label",
+			query: Query{LabelSelector: "app=redis", Kinds: []types.ResourceKind{types.ResourceKindPod}},
+			want: map[types.ResourceKind][]string{
+				types.ResourceKindPod: {"member1/redis-0"},
+			},
+			wantFailures: 1,
+		},
+		{
+			name:  "limited",
+			query: Query{Name: "nginx", Kinds: []types.ResourceKind{types.ResourceKindDeployment}, Limit: 1},
+			want: map[types.ResourceKind][]string{
+				types.ResourceKindDeployment: {"/nginx"},
+			},
+			wantFailures:  1,
+			wantTruncated: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := Search(context.TODO(), targets, nil, tc.query)
+			if err != nil {
+				t.Fatalf("Search() error = %v", err)
+			}
+			got := map[types.ResourceKind][]string{}
+			for _, group := range result.Groups {
+				for _, item := range group.Items {
+					got[group.Kind] = append(got[group.Kind], item.Cluster+"/"+item.Name)
+				}
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Search() = %v, want %v", got, tc.want)
+			}
+			if len(result.Failures) != tc.wantFailures {
+				t.Errorf("Search() failures = %v, want %d", result.Failures, tc.wantFailures)
+			}
+			for _, failure := range result.Failures {
+				if failure.Cluster != "member2" {
+					t.Errorf("unexpected failure %v", failure)
+				}
+			}
+			if result.Truncated != tc.wantTruncated {
+				t.Errorf("Search() truncated = %v, want %v", result.Truncated, tc.wantTruncated)
+			}
+		})
+	}
+
+	if _, err := Search(context.TODO(), targets, nil, Query{Name: "nginx", Kinds: []types.ResourceKind{types.ResourceKindNode}}); err == nil {
+		t.Errorf("Search() of an unsupported kind succeeded")
+	}
+}
+
+func TestNewCache(t *testing.T) {
+	clusters := []clusterv1alpha1.Cluster{
+		{ObjectMeta: metav1.ObjectMeta{Name: "member1"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "member2"}},
+	}
+	registries := []searchv1alpha1.ResourceRegistry{
+		{Spec: searchv1alpha1.ResourceRegistrySpec{
+			TargetCluster:     policyv1alpha1.ClusterAffinity{ClusterNames: []string{"member1"}},
+			ResourceSelectors: []searchv1alpha1.ResourceSelector{{APIVersion: "apps/v1", Kind: "Deployment"}},
+		}},
+		{Spec: searchv1alpha1.ResourceRegistrySpec{
+			ResourceSelectors: []searchv1alpha1.ResourceSelector{
+				{APIVersion: "v1", Kind: "Pod", Namespace: "kube-system"},
+				{APIVersion: "v1", Kind: "Namespace", Namespace: "kube-system"},
+			},
+		}},
+	}
+	cases := []struct {
+		name      string
+		namespace string
+		want      map[types.ResourceKind][]string
+	}{
+		{
+			name: "all namespaces",
+			want: map[types.ResourceKind][]string{
+				types.ResourceKindDeployment: {"member1"},
+				types.ResourceKindNamespace:  {"member1", "member2"},
+			},
+		},
+		{
+			name:      "namespace of a selector",
+			namespace: "kube-system",
+			want: map[types.ResourceKind][]string{
+				types.ResourceKindDeployment: {"member1"},
+				types.ResourceKindPod:        {"member1", "member2"},
+				types.ResourceKindNamespace:  {"member1", "member2"},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cache := NewCache(nil, registries, clusters, tc.namespace)
+			got := map[types.ResourceKind][]string{}
+			for kind, clusters := range cache.Clusters {
+				got[kind] = sets.List(clusters)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("NewCache() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSearchCache(t *testing.T) {
+	cached := func(cluster, name string) metav1.PartialObjectMetadata {
+		return metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name,
+			Annotations: map[string]string{clusterv1alpha1.CacheSourceAnnotationKey: cluster}}}
+	}
+	var paths []string
+	proxy := &restfake.RESTClient{
+		NegotiatedSerializer: scheme.Codecs.WithoutConversion(),
+		Client: restfake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+			paths = append(paths, req.URL.Path)
+			body, _ := json.Marshal(&metav1.PartialObjectMetadataList{Items: []metav1.PartialObjectMetadata{
+				cached("member1", "nginx"), cached("member2", "nginx"), cached("member3", "nginx"),
+			}})
+			return &http.Response{StatusCode: http.StatusOK, Header: http.Header{"Content-Type": []string{"application/json"}},
+				Body: io.NopCloser(strings.NewReader(string(body)))}, nil
+		}),
+	}
+	listed := newClient(newMetadata("apps/v1", "Deployment", "default", "nginx", nil))
+	listed.PrependReactor("list", "*", func(clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, fmt.Errorf("deployments are cached, the cluster must not be listed")
+	})
+	targets := []Target{
+		{Client: newClient(newMetadata("apps/v1", "Deployment", "default", "nginx", nil))},
+		{Cluster: "member1", Client: listed},
+		{Cluster: "member2", Client: listed},
+	}
+	cache := &Cache{Client: proxy, Clusters: map[types.ResourceKind]sets.Set[string]{
+		types.ResourceKindDeployment: sets.New("member1", "member2", "member3"),
+	}}
+
+	result, err := Search(context.TODO(), targets, cache, Query{Name: "nginx", Namespace: "default",
+		Kinds: []types.ResourceKind{types.ResourceKindDeployment}})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(result.Failures) != 0 {
+		t.Errorf("Search() failures = %v, want none", result.Failures)
+	}
+	var got []string
+	for _, group := range result.Groups {
+		for _, item := range group.Items {
+			got = append(got, item.Cluster+"/"+item.Name)
+		}
+	}
+	// member3 is cached but not searched
+	if want := []string{"/nginx", "member1/nginx", "member2/nginx"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Search() = %v, want %v", got, want)
+	}
+	if want := []string{"/apis/apps/v1/namespaces/default/deployments"}; !reflect.DeepEqual(paths, want) {
+		t.Errorf("cache requests = %v, want %v", paths, want)
+	}
+}
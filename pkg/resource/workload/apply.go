@@ -0,0 +1,206 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/klog/v2"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/resource/propagationpolicy"
+)
+
+// objectKinds maps the kinds supported by create, update and delete to their group version kind.
+var objectKinds = map[types.ResourceKind]schema.GroupVersionKind{
+	types.ResourceKindDeployment:  appsv1.SchemeGroupVersion.WithKind("Deployment"),
+	types.ResourceKindStatefulSet: appsv1.SchemeGroupVersion.WithKind("StatefulSet"),
+	types.ResourceKindDaemonSet:   appsv1.SchemeGroupVersion.WithKind("DaemonSet"),
+	types.ResourceKindJob:         batchv1.SchemeGroupVersion.WithKind("Job"),
+	types.ResourceKindCronJob:     batchv1.SchemeGroupVersion.WithKind("CronJob"),
+	types.ResourceKindService:     corev1.SchemeGroupVersion.WithKind("Service"),
+	types.ResourceKindIngress:     networkingv1.SchemeGroupVersion.WithKind("Ingress"),
+	types.ResourceKindConfigMap:   corev1.SchemeGroupVersion.WithKind("ConfigMap"),
+	types.ResourceKindSecret:      corev1.SchemeGroupVersion.WithKind("Secret"),
+}
+
+// CreateResult is the outcome of creating a workload.
+type CreateResult struct {
+	Object ctrlclient.Object `json:"object"`
+	// PropagationPolicy is the policy created along with the workload, if any.
+	PropagationPolicy *policyv1alpha1.PropagationPolicy `json:"propagationPolicy,omitempty"`
+}
+
+// NewObject returns an empty object of the given kind.
+func NewObject(kind types.ResourceKind) (ctrlclient.Object, schema.GroupVersionKind, error) {
+	gvk, ok := objectKinds[kind]
+	if !ok {
+		return nil, gvk, errors.NewBadRequest(fmt.Sprintf("%s is not supported", kind))
+	}
+	var obj ctrlclient.Object
+	switch kind {
+	case types.ResourceKindDeployment:
+		obj = &appsv1.Deployment{}
+	case types.ResourceKindStatefulSet:
+		obj = &appsv1.StatefulSet{}
+	case types.ResourceKindDaemonSet:
+		obj = &appsv1.DaemonSet{}
+	case types.ResourceKindJob:
+		obj = &batchv1.Job{}
+	case types.ResourceKindCronJob:
+		obj = &batchv1.CronJob{}
+	case types.ResourceKindService:
+		obj = &corev1.Service{}
+	case types.ResourceKindIngress:
+		obj = &networkingv1.Ingress{}
+	case types.ResourceKindConfigMap:
+		obj = &corev1.ConfigMap{}
+	case types.ResourceKindSecret:
+		obj = &corev1.Secret{}
+	}
+	return obj, gvk, nil
+}
+
+// Decode decodes the yaml or json content into an object of the given kind. The namespace of the object
+// defaults to namespace, and a MsgDeployNamespaceMismatchError is returned if both are set and differ, so
+// that the object is never silently moved to another namespace. When namespace is empty too, the object
+// lands in the default namespace.
+func Decode(kind types.ResourceKind, content, namespace string) (ctrlclient.Object, error) {
+	obj, gvk, err := NewObject(kind)
+	if err != nil {
+		return nil, err
+	}
+	if err = yaml.Unmarshal([]byte(content), obj); err != nil {
+		return nil, errors.NewBadRequest(fmt.Sprintf("failed to decode %s: %v", kind, err))
+	}
+	typeMeta := obj.GetObjectKind().GroupVersionKind()
+	if (typeMeta.Kind != "" && typeMeta.Kind != gvk.Kind) || (typeMeta.Version != "" && typeMeta.GroupVersion() != gvk.GroupVersion()) {
+		return nil, errors.NewBadRequest(fmt.Sprintf("expected %s, got %s", gvk, typeMeta))
+	}
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+
+	switch {
+	case obj.GetNamespace() == "" && namespace == "":
+		obj.SetNamespace(metav1.NamespaceDefault)
+	case obj.GetNamespace() == "":
+		obj.SetNamespace(namespace)
+	case namespace != "" && obj.GetNamespace() != namespace:
+		return nil, errors.NewBadRequest(errors.MsgDeployNamespaceMismatchError)
+	}
+	return obj, nil
+}
+
+// NewPropagationPolicy returns a PropagationPolicy selecting exactly obj, with the placement and other
+// settings of spec. The policy is named after the object when name is empty.
+func NewPropagationPolicy(obj ctrlclient.Object, name string, spec policyv1alpha1.PropagationSpec) (*policyv1alpha1.PropagationPolicy, error) {
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	if name == "" {
+		name = fmt.Sprintf("%s-%s", obj.GetName(), strings.ToLower(gvk.Kind))
+	}
+	spec.ResourceSelectors = []policyv1alpha1.ResourceSelector{{
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+	}}
+	propagationpolicy.SetDefaultPropagationSpec(obj.GetNamespace(), &spec)
+	if errs := propagationpolicy.ValidatePropagationPolicy(name, obj.GetNamespace(), &spec); len(errs) != 0 {
+		return nil, k8serrors.NewInvalid(policyv1alpha1.SchemeGroupVersion.WithKind(policyv1alpha1.ResourceKindPropagationPolicy).GroupKind(), name, errs)
+	}
+	return &policyv1alpha1.PropagationPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: obj.GetNamespace(), Name: name},
+		Spec:       spec,
+	}, nil
+}
+
+// Create creates the workload on the karmada control plane, along with the PropagationPolicy pp if it's
+// not nil. Both are validated by a dry run first, the policy is created before the workload so that it's
+// claimed by the policy right away, and the policy is removed again if the workload can't be created.
+func Create(ctx context.Context, c ctrlclient.Client, obj ctrlclient.Object, pp *policyv1alpha1.PropagationPolicy) (*CreateResult, error) {
+	obj.SetResourceVersion("")
+	if pp == nil {
+		if err := c.Create(ctx, obj); err != nil {
+			return nil, err
+		}
+		return &CreateResult{Object: obj}, nil
+	}
+
+	if err := c.Create(ctx, obj.DeepCopyObject().(ctrlclient.Object), ctrlclient.DryRunAll); err != nil {
+		return nil, err
+	}
+	if err := c.Create(ctx, pp.DeepCopy(), ctrlclient.DryRunAll); err != nil {
+		return nil, err
+	}
+	if err := c.Create(ctx, pp); err != nil {
+		return nil, err
+	}
+	if err := c.Create(ctx, obj); err != nil {
+		if deleteErr := c.Delete(ctx, pp); deleteErr != nil {
+			klog.ErrorS(deleteErr, "Failed to delete PropagationPolicy after failing to create its workload",
+				"namespace", pp.Namespace, "name", pp.Name)
+		}
+		return nil, err
+	}
+	return &CreateResult{Object: obj, PropagationPolicy: pp}, nil
+}
+
+// Update replaces the workload namespace/name with obj. The resourceVersion of obj is required and used as
+// precondition, so that changes made since the workload was read, e.g. by karmada, are not overwritten.
+func Update(ctx context.Context, c ctrlclient.Client, kind types.ResourceKind, namespace, name string, obj ctrlclient.Object) (ctrlclient.Object, error) {
+	if obj.GetNamespace() != namespace {
+		return nil, errors.NewBadRequest(errors.MsgDeployNamespaceMismatchError)
+	}
+	if obj.GetName() == "" {
+		obj.SetName(name)
+	} else if obj.GetName() != name {
+		return nil, errors.NewBadRequest(fmt.Sprintf("the name %q does not match the name %q of the request", obj.GetName(), name))
+	}
+	if obj.GetResourceVersion() == "" {
+		return nil, k8serrors.NewInvalid(objectKinds[kind].GroupKind(), name, field.ErrorList{
+			field.Required(field.NewPath("metadata", "resourceVersion"), "the resourceVersion the workload was read at is required"),
+		})
+	}
+	if err := c.Update(ctx, obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// Delete deletes the workload, the objects it owns are deleted in the background.
+func Delete(ctx context.Context, c ctrlclient.Client, kind types.ResourceKind, namespace, name string) error {
+	obj, _, err := NewObject(kind)
+	if err != nil {
+		return err
+	}
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	return c.Delete(ctx, obj, ctrlclient.PropagationPolicy(metav1.DeletePropagationBackground))
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"context"
+	"testing"
+
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/util/gclient"
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+)
+
+func TestDecode(t *testing.T) {
+	cases := []struct {
+		name          string
+		kind          types.ResourceKind
+		content       string
+		namespace     string
+		wantNamespace string
+		wantErr       string
+	}{
+		{
+			name:          "namespace of the request",
+			kind:          types.ResourceKindDeployment,
+			content:       "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: nginx\n",
+			namespace:     "test",
+			wantNamespace: "test",
+		},
+		{
+			name:          "namespace of the content",
+			kind:          types.ResourceKindConfigMap,
+			content:       "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: nginx\n  namespace: test\n",
+			wantNamespace: "test",
+		},
+		{
+			name:          "default namespace",
+			kind:          types.ResourceKindService,
+			content:       "metadata:\n  name: nginx\n",
+			wantNamespace: metav1.NamespaceDefault,
+		},
+		{
+			name:      "namespace mismatch",
+			kind:      types.ResourceKindDeployment,
+			content:   "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: nginx\n  namespace: test\n",
+			namespace: "default",
+			wantErr:   errors.MsgDeployNamespaceMismatchError,
+		},
+		{
+			name:    "kind mismatch",
+			kind:    types.ResourceKindStatefulSet,
+			content: "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: nginx\n",
+			wantErr: "expected apps/v1, Kind=StatefulSet, got apps/v1, Kind=Deployment",
+		},
+		{
+			name:    "unsupported kind",
+			kind:    types.ResourceKindPod,
+			content: "metadata:\n  name: nginx\n",
+			wantErr: "pod is not supported",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			obj, err := Decode(tc.kind, tc.content, tc.namespace)
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Fatalf("Decode() error = %v, want %s", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+			if obj.GetNamespace() != tc.wantNamespace {
+				t.Errorf("Decode() namespace = %s, want %s", obj.GetNamespace(), tc.wantNamespace)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	newDeployment := func() ctrlclient.Object {
+		obj, err := Decode(types.ResourceKindDeployment, "metadata:\n  name: nginx\n", "default")
+		if err != nil {
+			t.Fatalf("Decode() error = %v", err)
+		}
+		return obj
+	}
+	spec := policyv1alpha1.PropagationSpec{Placement: policyv1alpha1.Placement{
+		ClusterAffinity: &policyv1alpha1.ClusterAffinity{ClusterNames: []string{"member1"}},
+	}}
+
+	c := fake.NewClientBuilder().WithScheme(gclient.NewSchema()).Build()
+	pp, err := NewPropagationPolicy(newDeployment(), "", spec)
+	if err != nil {
+		t.Fatalf("NewPropagationPolicy() error = %v", err)
+	}
+	if pp.Name != "nginx-deployment" || len(pp.Spec.ResourceSelectors) != 1 || pp.Spec.ResourceSelectors[0].Name != "nginx" {
+		t.Errorf("NewPropagationPolicy() = %s selecting %v", pp.Name, pp.Spec.ResourceSelectors)
+	}
+	if _, err = Create(context.TODO(), c, newDeployment(), pp); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if err = c.Get(context.TODO(), ctrlclient.ObjectKey{Namespace: "default", Name: "nginx"}, &appsv1.Deployment{}); err != nil {
+		t.Errorf("deployment is not created: %v", err)
+	}
+
+	// the deployment exists, the policy must not be left behind
+	pp, _ = NewPropagationPolicy(newDeployment(), "another", spec)
+	if _, err = Create(context.TODO(), c, newDeployment(), pp); !k8serrors.IsAlreadyExists(err) {
+		t.Fatalf("Create() error = %v, want already exists", err)
+	}
+	err = c.Get(context.TODO(), ctrlclient.ObjectKey{Namespace: "default", Name: "another"}, &policyv1alpha1.PropagationPolicy{})
+	if !k8serrors.IsNotFound(err) {
+		t.Errorf("policy is left behind, get error = %v", err)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(gclient.NewSchema()).Build()
+	obj, _ := Decode(types.ResourceKindDeployment, "metadata:\n  name: nginx\n", "default")
+	if err := c.Create(context.TODO(), obj); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	resourceVersion := obj.GetResourceVersion()
+
+	cases := []struct {
+		name            string
+		resourceVersion string
+		check           func(error) bool
+	}{
+		{name: "without resourceVersion", resourceVersion: "", check: k8serrors.IsInvalid},
+		{name: "current resourceVersion", resourceVersion: resourceVersion, check: func(err error) bool { return err == nil }},
+		{name: "stale resourceVersion", resourceVersion: resourceVersion, check: k8serrors.IsConflict},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			obj, _ := Decode(types.ResourceKindDeployment, "metadata:\n  name: nginx\n  labels:\n    app: nginx\n", "default")
+			obj.SetResourceVersion(tc.resourceVersion)
+			if _, err := Update(context.TODO(), c, types.ResourceKindDeployment, "default", "nginx", obj); !tc.check(err) {
+				t.Errorf("Update() error = %v", err)
+			}
+		})
+	}
+}
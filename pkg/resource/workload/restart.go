@@ -0,0 +1,54 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+)
+
+// restartedAtAnnotation is the pod template annotation kubectl rollout restart sets.
+const restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+// Restart triggers a rolling restart of the workload like kubectl rollout restart, by changing an
+// annotation of its pod template. Done on a resource template, the change is propagated and the workload
+// restarts in every member cluster.
+func Restart(client kubernetes.Interface, kind types.ResourceKind, namespace, name string) error {
+	if !kind.Restartable() {
+		return errors.NewBadRequest(fmt.Sprintf("%s is not restartable", kind))
+	}
+	patch := []byte(fmt.Sprintf(`{"spec":{"template":{"metadata":{"annotations":{%q:%q}}}}}`,
+		restartedAtAnnotation, time.Now().Format(time.RFC3339)))
+	var err error
+	switch kind {
+	case types.ResourceKindDeployment:
+		_, err = client.AppsV1().Deployments(namespace).Patch(context.TODO(), name, k8stypes.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	case types.ResourceKindStatefulSet:
+		_, err = client.AppsV1().StatefulSets(namespace).Patch(context.TODO(), name, k8stypes.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	case types.ResourceKindDaemonSet:
+		_, err = client.AppsV1().DaemonSets(namespace).Patch(context.TODO(), name, k8stypes.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	}
+	return err
+}
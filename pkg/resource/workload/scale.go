@@ -0,0 +1,232 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"context"
+	"fmt"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/util"
+	"github.com/karmada-io/karmada/pkg/util/helper"
+	"github.com/karmada-io/karmada/pkg/util/names"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+)
+
+// apiKinds maps the kinds supported by scale and restart to their API kind.
+var apiKinds = map[types.ResourceKind]string{
+	types.ResourceKindDeployment:            "Deployment",
+	types.ResourceKindStatefulSet:           "StatefulSet",
+	types.ResourceKindDaemonSet:             "DaemonSet",
+	types.ResourceKindReplicaSet:            "ReplicaSet",
+	types.ResourceKindReplicationController: "ReplicationController",
+}
+
+// ScaleResult is the outcome of scaling a workload.
+type ScaleResult struct {
+	Kind            types.ResourceKind `json:"kind"`
+	Namespace       string             `json:"namespace"`
+	Name            string             `json:"name"`
+	Replicas        int32              `json:"replicas"`
+	DesiredReplicas int32              `json:"desiredReplicas"`
+	DryRun          bool               `json:"dryRun"`
+	// SchedulingType is how the replicas are scheduled to the member clusters, empty for a workload that
+	// is not propagated or when scaling directly in a member cluster.
+	SchedulingType policyv1alpha1.ReplicaSchedulingType `json:"schedulingType,omitempty"`
+	// Distribution is the current split of the replicas among the member clusters.
+	Distribution []workv1alpha2.TargetCluster `json:"distribution"`
+	// ExpectedDistribution is the split the scheduler is expected to make for the desired replicas. It's
+	// an estimation, the scheduler decides on dynamic weights with the up-to-date cluster resources.
+	ExpectedDistribution []workv1alpha2.TargetCluster `json:"expectedDistribution"`
+}
+
+// Scale sets the replicas of a workload through its scale subresource. When karmadaClient is set, the
+// workload is a resource template of the control plane and the split of the replicas among the member
+// clusters is estimated from its ResourceBinding.
+func Scale(kubeClient kubernetes.Interface, karmadaClient karmadaclientset.Interface, kind types.ResourceKind,
+	namespace, name string, replicas int32, dryRun bool) (*ScaleResult, error) {
+	if !kind.Scalable() {
+		return nil, errors.NewBadRequest(fmt.Sprintf("%s is not scalable", kind))
+	}
+	scale, err := getScale(kubeClient, kind, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	result := &ScaleResult{
+		Kind:                 kind,
+		Namespace:            namespace,
+		Name:                 name,
+		Replicas:             scale.Spec.Replicas,
+		DesiredReplicas:      replicas,
+		DryRun:               dryRun,
+		Distribution:         []workv1alpha2.TargetCluster{},
+		ExpectedDistribution: []workv1alpha2.TargetCluster{},
+	}
+	if !dryRun {
+		scale.Spec.Replicas = replicas
+		if _, err = updateScale(kubeClient, kind, namespace, name, scale); err != nil {
+			return nil, err
+		}
+	}
+	if karmadaClient == nil {
+		return result, nil
+	}
+
+	binding, err := karmadaClient.WorkV1alpha2().ResourceBindings(namespace).Get(context.TODO(),
+		names.GenerateBindingName(apiKinds[kind], name), metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return result, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	clusters, err := karmadaClient.ClusterV1alpha1().Clusters().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	result.SchedulingType = policyv1alpha1.ReplicaSchedulingTypeDuplicated
+	if binding.Spec.Placement != nil && binding.Spec.Placement.ReplicaScheduling != nil {
+		result.SchedulingType = binding.Spec.Placement.ReplicaScheduling.ReplicaSchedulingType
+	}
+	result.Distribution = append(result.Distribution, binding.Spec.Clusters...)
+	result.ExpectedDistribution = EstimateDistribution(binding.Spec.Placement, binding.Spec.Clusters, clusters.Items, replicas)
+	return result, nil
+}
+
+// EstimateDistribution estimates how the scheduler splits the replicas among the member clusters. Duplicated
+// workloads get all the replicas in every cluster, static weights are applied like the scheduler does, and
+// dynamic weights or aggregated division keep the proportions of the current split.
+func EstimateDistribution(placement *policyv1alpha1.Placement, scheduled []workv1alpha2.TargetCluster,
+	clusters []clusterv1alpha1.Cluster, replicas int32) []workv1alpha2.TargetCluster {
+	result := make([]workv1alpha2.TargetCluster, 0, len(scheduled))
+	if placement == nil || placement.ReplicaScheduling == nil ||
+		placement.ReplicaScheduling.ReplicaSchedulingType != policyv1alpha1.ReplicaSchedulingTypeDivided {
+		for _, target := range scheduled {
+			result = append(result, workv1alpha2.TargetCluster{Name: target.Name, Replicas: replicas})
+		}
+		return result
+	}
+	if replicas == 0 || len(scheduled) == 0 {
+		for _, target := range scheduled {
+			result = append(result, workv1alpha2.TargetCluster{Name: target.Name})
+		}
+		return result
+	}
+
+	weightPreference := placement.ReplicaScheduling.WeightPreference
+	if placement.ReplicaScheduling.ReplicaDivisionPreference == policyv1alpha1.ReplicaDivisionPreferenceWeighted &&
+		weightPreference != nil && len(weightPreference.StaticWeightList) > 0 && weightPreference.DynamicWeight == "" {
+		weights := staticWeights(placement, weightPreference.StaticWeightList, scheduled, clusters)
+		dispenser := helper.NewDispenser(replicas, nil)
+		dispenser.TakeByWeight(weights)
+		return dispenser.Result
+	}
+
+	// the current split is the best guess of the available replicas of the clusters
+	weights := scheduled
+	if totalReplicas(scheduled) == 0 {
+		weights = make([]workv1alpha2.TargetCluster, 0, len(scheduled))
+		for _, target := range scheduled {
+			weights = append(weights, workv1alpha2.TargetCluster{Name: target.Name, Replicas: 1})
+		}
+	}
+	return helper.SpreadReplicasByTargetClusters(replicas, weights, nil)
+}
+
+// staticWeights returns the weights of the clusters matching the placement, the highest weight of the
+// rules matching a cluster is its weight and every cluster weighs the same when no rule matches.
+func staticWeights(placement *policyv1alpha1.Placement, rules []policyv1alpha1.StaticClusterWeight,
+	scheduled []workv1alpha2.TargetCluster, clusters []clusterv1alpha1.Cluster) helper.ClusterWeightInfoList {
+	candidates := make([]*clusterv1alpha1.Cluster, 0, len(clusters))
+	for i := range clusters {
+		if placement.ClusterAffinity == nil || util.ClusterMatches(&clusters[i], *placement.ClusterAffinity) {
+			candidates = append(candidates, &clusters[i])
+		}
+	}
+
+	weights := make(helper.ClusterWeightInfoList, 0, len(candidates))
+	for _, cluster := range candidates {
+		var weight int64
+		for _, rule := range rules {
+			if util.ClusterMatches(cluster, rule.TargetCluster) && rule.Weight > weight {
+				weight = rule.Weight
+			}
+		}
+		if weight > 0 {
+			weights = append(weights, helper.ClusterWeightInfo{ClusterName: cluster.Name, Weight: weight, LastReplicas: lastReplicas(scheduled, cluster.Name)})
+		}
+	}
+	if weights.GetWeightSum() == 0 {
+		for _, cluster := range candidates {
+			weights = append(weights, helper.ClusterWeightInfo{ClusterName: cluster.Name, Weight: 1, LastReplicas: lastReplicas(scheduled, cluster.Name)})
+		}
+	}
+	return weights
+}
+
+func lastReplicas(scheduled []workv1alpha2.TargetCluster, name string) int32 {
+	for _, target := range scheduled {
+		if target.Name == name {
+			return target.Replicas
+		}
+	}
+	return 0
+}
+
+func totalReplicas(targets []workv1alpha2.TargetCluster) int32 {
+	var total int32
+	for _, target := range targets {
+		total += target.Replicas
+	}
+	return total
+}
+
+func getScale(client kubernetes.Interface, kind types.ResourceKind, namespace, name string) (*autoscalingv1.Scale, error) {
+	switch kind {
+	case types.ResourceKindDeployment:
+		return client.AppsV1().Deployments(namespace).GetScale(context.TODO(), name, metav1.GetOptions{})
+	case types.ResourceKindStatefulSet:
+		return client.AppsV1().StatefulSets(namespace).GetScale(context.TODO(), name, metav1.GetOptions{})
+	case types.ResourceKindReplicaSet:
+		return client.AppsV1().ReplicaSets(namespace).GetScale(context.TODO(), name, metav1.GetOptions{})
+	case types.ResourceKindReplicationController:
+		return client.CoreV1().ReplicationControllers(namespace).GetScale(context.TODO(), name, metav1.GetOptions{})
+	}
+	return nil, errors.NewBadRequest(fmt.Sprintf("%s is not scalable", kind))
+}
+
+func updateScale(client kubernetes.Interface, kind types.ResourceKind, namespace, name string, scale *autoscalingv1.Scale) (*autoscalingv1.Scale, error) {
+	switch kind {
+	case types.ResourceKindDeployment:
+		return client.AppsV1().Deployments(namespace).UpdateScale(context.TODO(), name, scale, metav1.UpdateOptions{})
+	case types.ResourceKindStatefulSet:
+		return client.AppsV1().StatefulSets(namespace).UpdateScale(context.TODO(), name, scale, metav1.UpdateOptions{})
+	case types.ResourceKindReplicaSet:
+		return client.AppsV1().ReplicaSets(namespace).UpdateScale(context.TODO(), name, scale, metav1.UpdateOptions{})
+	case types.ResourceKindReplicationController:
+		return client.CoreV1().ReplicationControllers(namespace).UpdateScale(context.TODO(), name, scale, metav1.UpdateOptions{})
+	}
+	return nil, errors.NewBadRequest(fmt.Sprintf("%s is not scalable", kind))
+}
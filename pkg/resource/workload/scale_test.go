@@ -0,0 +1,88 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestEstimateDistribution(t *testing.T) {
+	clusters := []clusterv1alpha1.Cluster{
+		{ObjectMeta: metav1.ObjectMeta{Name: "member1"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "member2"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "member3"}},
+	}
+	scheduled := []workv1alpha2.TargetCluster{{Name: "member1", Replicas: 3}, {Name: "member2", Replicas: 1}}
+	divided := func(preference policyv1alpha1.ReplicaDivisionPreference, weights *policyv1alpha1.ClusterPreferences) *policyv1alpha1.Placement {
+		return &policyv1alpha1.Placement{ReplicaScheduling: &policyv1alpha1.ReplicaSchedulingStrategy{
+			ReplicaSchedulingType:     policyv1alpha1.ReplicaSchedulingTypeDivided,
+			ReplicaDivisionPreference: preference,
+			WeightPreference:          weights,
+		}}
+	}
+	cases := []struct {
+		name      string
+		placement *policyv1alpha1.Placement
+		replicas  int32
+		want      []workv1alpha2.TargetCluster
+	}{
+		{
+			name:     "duplicated",
+			replicas: 5,
+			want:     []workv1alpha2.TargetCluster{{Name: "member1", Replicas: 5}, {Name: "member2", Replicas: 5}},
+		},
+		{
+			name: "static weights",
+			placement: divided(policyv1alpha1.ReplicaDivisionPreferenceWeighted, &policyv1alpha1.ClusterPreferences{
+				StaticWeightList: []policyv1alpha1.StaticClusterWeight{
+					{TargetCluster: policyv1alpha1.ClusterAffinity{ClusterNames: []string{"member1"}}, Weight: 2},
+					{TargetCluster: policyv1alpha1.ClusterAffinity{ClusterNames: []string{"member3"}}, Weight: 1},
+				},
+			}),
+			replicas: 6,
+			want:     []workv1alpha2.TargetCluster{{Name: "member1", Replicas: 4}, {Name: "member3", Replicas: 2}},
+		},
+		{
+			name:      "dynamic weights keep the current proportions",
+			placement: divided(policyv1alpha1.ReplicaDivisionPreferenceWeighted, &policyv1alpha1.ClusterPreferences{DynamicWeight: policyv1alpha1.DynamicWeightByAvailableReplicas}),
+			replicas:  8,
+			want:      []workv1alpha2.TargetCluster{{Name: "member1", Replicas: 6}, {Name: "member2", Replicas: 2}},
+		},
+		{
+			name:      "scaled to zero",
+			placement: divided(policyv1alpha1.ReplicaDivisionPreferenceAggregated, nil),
+			replicas:  0,
+			want:      []workv1alpha2.TargetCluster{{Name: "member1"}, {Name: "member2"}},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := EstimateDistribution(tc.placement, scheduled, clusters, tc.replicas)
+			sort.Slice(got, func(i, j int) bool { return got[i].Name < got[j].Name })
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("EstimateDistribution() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloadrebalancer
+
+import (
+	"context"
+
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// WorkloadRebalancerDetail is a presentation layer view of Karmada WorkloadRebalancer resource.
+type WorkloadRebalancerDetail struct {
+	// Extends list item structure.
+	WorkloadRebalancer `json:",inline"`
+
+	Workloads []WorkloadResult `json:"workloads"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetWorkloadRebalancerDetail gets WorkloadRebalancer details.
+func GetWorkloadRebalancerDetail(client karmadaclientset.Interface, name string) (*WorkloadRebalancerDetail, error) {
+	rebalancer, err := client.AppsV1alpha1().WorkloadRebalancers().Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	results := workloadResults(rebalancer)
+	return &WorkloadRebalancerDetail{
+		WorkloadRebalancer: toWorkloadRebalancer(rebalancer, results),
+		Workloads:          results,
+		Errors:             []error{},
+	}, nil
+}
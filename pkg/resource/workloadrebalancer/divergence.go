@@ -0,0 +1,240 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloadrebalancer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	appsv1alpha1 "github.com/karmada-io/karmada/pkg/apis/apps/v1alpha1"
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"github.com/karmada-io/karmada/pkg/util"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+)
+
+// DivergenceReason is the reason why the current placement of a workload differs from a fresh schedule.
+type DivergenceReason string
+
+const (
+	// DivergenceScheduleOutdated means the binding has changed since the scheduler last observed it.
+	DivergenceScheduleOutdated DivergenceReason = "ScheduleOutdated"
+	// DivergenceUnscheduled means the workload is not placed on any cluster.
+	DivergenceUnscheduled DivergenceReason = "Unscheduled"
+	// DivergenceClusterNotFound means the workload is placed on a cluster that no longer exists.
+	DivergenceClusterNotFound DivergenceReason = "ClusterNotFound"
+	// DivergenceClusterNotMatched means the workload is placed on a cluster that the cluster affinity no longer selects.
+	DivergenceClusterNotMatched DivergenceReason = "ClusterNotMatched"
+	// DivergenceTaintNotTolerated means the workload is placed on a cluster with a taint it does not tolerate.
+	DivergenceTaintNotTolerated DivergenceReason = "TaintNotTolerated"
+	// DivergenceReplicasMismatch means the replicas assigned to clusters do not add up to the desired replicas.
+	DivergenceReplicasMismatch DivergenceReason = "ReplicasMismatch"
+)
+
+// Divergence describes a single difference between the current and a fresh placement.
+type Divergence struct {
+	Reason DivergenceReason `json:"reason"`
+	// Cluster is the member cluster the divergence is about, empty if it is about the whole placement.
+	Cluster string `json:"cluster,omitempty"`
+	Message string `json:"message"`
+}
+
+// DivergentWorkload is a workload whose current placement diverges from what a fresh schedule would produce.
+// Workload can be used as is in the spec of a WorkloadRebalancer to trigger the rescheduling.
+type DivergentWorkload struct {
+	Workload    appsv1alpha1.ObjectReference `json:"workload"`
+	Clusters    []workv1alpha2.TargetCluster `json:"clusters"`
+	Divergences []Divergence                 `json:"divergences"`
+}
+
+// DivergentWorkloadList contains the divergent workloads in the karmada control-plane.
+type DivergentWorkloadList struct {
+	Items []DivergentWorkload `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// GetDivergentWorkloadList lists the bindings and clusters in the karmada control-plane and returns the
+// workloads whose placement diverges. Cluster scoped workloads are only included when querying all namespaces.
+func GetDivergentWorkloadList(client karmadaclientset.Interface, nsQuery *common.NamespaceQuery) (*DivergentWorkloadList, error) {
+	clusters, err := client.ClusterV1alpha1().Clusters().List(context.TODO(), helpers.ListEverything)
+	if err != nil {
+		return nil, err
+	}
+	bindings, err := client.WorkV1alpha2().ResourceBindings(nsQuery.ToRequestParam()).List(context.TODO(), helpers.ListEverything)
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+	clusterBindings := &workv1alpha2.ClusterResourceBindingList{}
+	if nsQuery.ToRequestParam() == metav1.NamespaceAll {
+		clusterBindings, err = client.WorkV1alpha2().ClusterResourceBindings().List(context.TODO(), helpers.ListEverything)
+		nonCriticalErrors, criticalError = errors.AppendError(err, nonCriticalErrors)
+		if criticalError != nil {
+			return nil, criticalError
+		}
+	}
+
+	return &DivergentWorkloadList{
+		Items:  FindDivergentWorkloads(bindings.Items, clusterBindings.Items, clusters.Items),
+		Errors: nonCriticalErrors,
+	}, nil
+}
+
+// FindDivergentWorkloads compares the scheduling result of every binding with the current clusters, and
+// returns the workloads which would be placed differently if they were scheduled again. It does not run the
+// scheduler, only the constraints that can be checked without the estimator are evaluated, so workloads
+// divided by dynamic weight are never reported for their replica distribution.
+func FindDivergentWorkloads(bindings []workv1alpha2.ResourceBinding, clusterBindings []workv1alpha2.ClusterResourceBinding,
+	clusters []clusterv1alpha1.Cluster) []DivergentWorkload {
+	clusterMap := make(map[string]*clusterv1alpha1.Cluster, len(clusters))
+	for i := range clusters {
+		clusterMap[clusters[i].Name] = &clusters[i]
+	}
+
+	result := make([]DivergentWorkload, 0)
+	add := func(generation int64, spec *workv1alpha2.ResourceBindingSpec, status *workv1alpha2.ResourceBindingStatus) {
+		divergences := findDivergences(generation, spec, status, clusterMap)
+		if len(divergences) == 0 {
+			return
+		}
+		result = append(result, DivergentWorkload{
+			Workload: appsv1alpha1.ObjectReference{
+				APIVersion: spec.Resource.APIVersion,
+				Kind:       spec.Resource.Kind,
+				Namespace:  spec.Resource.Namespace,
+				Name:       spec.Resource.Name,
+			},
+			Clusters:    spec.Clusters,
+			Divergences: divergences,
+		})
+	}
+	for i := range bindings {
+		add(bindings[i].Generation, &bindings[i].Spec, &bindings[i].Status)
+	}
+	for i := range clusterBindings {
+		add(clusterBindings[i].Generation, &clusterBindings[i].Spec, &clusterBindings[i].Status)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Workload.Namespace != result[j].Workload.Namespace {
+			return result[i].Workload.Namespace < result[j].Workload.Namespace
+		}
+		if result[i].Workload.Kind != result[j].Workload.Kind {
+			return result[i].Workload.Kind < result[j].Workload.Kind
+		}
+		return result[i].Workload.Name < result[j].Workload.Name
+	})
+	return result
+}
+
+func findDivergences(generation int64, spec *workv1alpha2.ResourceBindingSpec, status *workv1alpha2.ResourceBindingStatus,
+	clusters map[string]*clusterv1alpha1.Cluster) []Divergence {
+	placement := spec.Placement
+	if placement == nil {
+		// the binding has not been claimed by any policy yet.
+		return nil
+	}
+
+	var divergences []Divergence
+	if status.SchedulerObservedGeneration != generation {
+		divergences = append(divergences, Divergence{
+			Reason:  DivergenceScheduleOutdated,
+			Message: fmt.Sprintf("binding generation %d has not been scheduled, last scheduled generation is %d", generation, status.SchedulerObservedGeneration),
+		})
+	}
+	if len(spec.Clusters) == 0 {
+		return append(divergences, Divergence{
+			Reason:  DivergenceUnscheduled,
+			Message: "workload is not placed on any cluster",
+		})
+	}
+
+	affinity := observedAffinity(placement, status.SchedulerObservedAffinityName)
+	var assigned int32
+	for _, target := range spec.Clusters {
+		assigned += target.Replicas
+		cluster, ok := clusters[target.Name]
+		if !ok {
+			divergences = append(divergences, Divergence{
+				Reason:  DivergenceClusterNotFound,
+				Cluster: target.Name,
+				Message: fmt.Sprintf("cluster %s does not exist", target.Name),
+			})
+			continue
+		}
+		if affinity != nil && !util.ClusterMatches(cluster, *affinity) {
+			divergences = append(divergences, Divergence{
+				Reason:  DivergenceClusterNotMatched,
+				Cluster: target.Name,
+				Message: fmt.Sprintf("cluster %s is not selected by the cluster affinity", target.Name),
+			})
+		}
+		for i := range cluster.Spec.Taints {
+			taint := &cluster.Spec.Taints[i]
+			if taint.Effect != corev1.TaintEffectNoSchedule && taint.Effect != corev1.TaintEffectNoExecute {
+				continue
+			}
+			if !tolerated(taint, placement.ClusterTolerations) {
+				divergences = append(divergences, Divergence{
+					Reason:  DivergenceTaintNotTolerated,
+					Cluster: target.Name,
+					Message: fmt.Sprintf("taint %s of cluster %s is not tolerated", taint.ToString(), target.Name),
+				})
+			}
+		}
+	}
+
+	if spec.Replicas > 0 && placement.ReplicaSchedulingType() == policyv1alpha1.ReplicaSchedulingTypeDivided && assigned != spec.Replicas {
+		divergences = append(divergences, Divergence{
+			Reason:  DivergenceReplicasMismatch,
+			Message: fmt.Sprintf("%d replicas are assigned to clusters, want %d", assigned, spec.Replicas),
+		})
+	}
+	return divergences
+}
+
+// observedAffinity returns the cluster affinity the binding was scheduled with, nil means any cluster.
+func observedAffinity(placement *policyv1alpha1.Placement, observedAffinityName string) *policyv1alpha1.ClusterAffinity {
+	if placement.ClusterAffinity != nil {
+		return placement.ClusterAffinity
+	}
+	for i := range placement.ClusterAffinities {
+		if placement.ClusterAffinities[i].AffinityName == observedAffinityName {
+			return &placement.ClusterAffinities[i].ClusterAffinity
+		}
+	}
+	return nil
+}
+
+func tolerated(taint *corev1.Taint, tolerations []corev1.Toleration) bool {
+	for i := range tolerations {
+		if tolerations[i].ToleratesTaint(taint) {
+			return true
+		}
+	}
+	return false
+}
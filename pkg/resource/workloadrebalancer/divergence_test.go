@@ -0,0 +1,129 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloadrebalancer
+
+import (
+	"reflect"
+	"testing"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFindDivergences(t *testing.T) {
+	clusters := map[string]*clusterv1alpha1.Cluster{
+		"member1": {ObjectMeta: metav1.ObjectMeta{Name: "member1", Labels: map[string]string{"env": "prod"}}},
+		"member2": {
+			ObjectMeta: metav1.ObjectMeta{Name: "member2"},
+			Spec: clusterv1alpha1.ClusterSpec{Taints: []corev1.Taint{
+				{Key: "cluster.karmada.io/not-ready", Effect: corev1.TaintEffectNoExecute},
+			}},
+		},
+	}
+	divided := &policyv1alpha1.Placement{
+		ReplicaScheduling: &policyv1alpha1.ReplicaSchedulingStrategy{
+			ReplicaSchedulingType: policyv1alpha1.ReplicaSchedulingTypeDivided,
+		},
+	}
+
+	cases := []struct {
+		name       string
+		generation int64
+		spec       workv1alpha2.ResourceBindingSpec
+		status     workv1alpha2.ResourceBindingStatus
+		want       []Divergence
+	}{
+		{
+			name: "not claimed by policy",
+			spec: workv1alpha2.ResourceBindingSpec{},
+		},
+		{
+			name:       "up to date",
+			generation: 1,
+			spec: workv1alpha2.ResourceBindingSpec{
+				Replicas:  3,
+				Placement: divided,
+				Clusters:  []workv1alpha2.TargetCluster{{Name: "member1", Replicas: 3}},
+			},
+			status: workv1alpha2.ResourceBindingStatus{SchedulerObservedGeneration: 1},
+		},
+		{
+			name:       "outdated and unscheduled",
+			generation: 2,
+			spec:       workv1alpha2.ResourceBindingSpec{Placement: &policyv1alpha1.Placement{}},
+			status:     workv1alpha2.ResourceBindingStatus{SchedulerObservedGeneration: 1},
+			want: []Divergence{
+				{Reason: DivergenceScheduleOutdated, Message: "binding generation 2 has not been scheduled, last scheduled generation is 1"},
+				{Reason: DivergenceUnscheduled, Message: "workload is not placed on any cluster"},
+			},
+		},
+		{
+			name:       "cluster removed and replicas mismatch",
+			generation: 1,
+			spec: workv1alpha2.ResourceBindingSpec{
+				Replicas:  4,
+				Placement: divided,
+				Clusters:  []workv1alpha2.TargetCluster{{Name: "member1", Replicas: 2}, {Name: "member3", Replicas: 1}},
+			},
+			status: workv1alpha2.ResourceBindingStatus{SchedulerObservedGeneration: 1},
+			want: []Divergence{
+				{Reason: DivergenceClusterNotFound, Cluster: "member3", Message: "cluster member3 does not exist"},
+				{Reason: DivergenceReplicasMismatch, Message: "3 replicas are assigned to clusters, want 4"},
+			},
+		},
+		{
+			name:       "affinity and taint",
+			generation: 1,
+			spec: workv1alpha2.ResourceBindingSpec{
+				Placement: &policyv1alpha1.Placement{
+					ClusterAffinities: []policyv1alpha1.ClusterAffinityTerm{{
+						AffinityName:    "prod",
+						ClusterAffinity: policyv1alpha1.ClusterAffinity{ClusterNames: []string{"member1"}},
+					}},
+				},
+				Clusters: []workv1alpha2.TargetCluster{{Name: "member1"}, {Name: "member2"}},
+			},
+			status: workv1alpha2.ResourceBindingStatus{SchedulerObservedGeneration: 1, SchedulerObservedAffinityName: "prod"},
+			want: []Divergence{
+				{Reason: DivergenceClusterNotMatched, Cluster: "member2", Message: "cluster member2 is not selected by the cluster affinity"},
+				{Reason: DivergenceTaintNotTolerated, Cluster: "member2", Message: "taint cluster.karmada.io/not-ready:NoExecute of cluster member2 is not tolerated"},
+			},
+		},
+		{
+			name:       "taint tolerated",
+			generation: 1,
+			spec: workv1alpha2.ResourceBindingSpec{
+				Placement: &policyv1alpha1.Placement{
+					ClusterTolerations: []corev1.Toleration{{Key: "cluster.karmada.io/not-ready", Operator: corev1.TolerationOpExists}},
+				},
+				Clusters: []workv1alpha2.TargetCluster{{Name: "member2"}},
+			},
+			status: workv1alpha2.ResourceBindingStatus{SchedulerObservedGeneration: 1},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := findDivergences(c.generation, &c.spec, &c.status, clusters)
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("findDivergences() = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloadrebalancer
+
+import (
+	"context"
+
+	appsv1alpha1 "github.com/karmada-io/karmada/pkg/apis/apps/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+)
+
+// RebalancePending is the result of a workload that has not been rebalanced by the controller yet.
+const RebalancePending appsv1alpha1.RebalanceResult = "Pending"
+
+// WorkloadRebalancerList contains a list of WorkloadRebalancers in the karmada control-plane.
+type WorkloadRebalancerList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+
+	// Unordered list of WorkloadRebalancers.
+	Items []WorkloadRebalancer `json:"items"`
+
+	// List of non-critical errors, that occurred during resource retrieval.
+	Errors []error `json:"errors"`
+}
+
+// WorkloadRebalancer contains information about a single WorkloadRebalancer.
+type WorkloadRebalancer struct {
+	ObjectMeta              types.ObjectMeta `json:"objectMeta"`
+	TypeMeta                types.TypeMeta   `json:"typeMeta"`
+	TTLSecondsAfterFinished *int32           `json:"ttlSecondsAfterFinished"`
+	FinishTime              *metaV1.Time     `json:"finishTime"`
+	Summary                 RebalanceSummary `json:"summary"`
+}
+
+// RebalanceSummary counts the workloads of a WorkloadRebalancer by result.
+type RebalanceSummary struct {
+	Total      int `json:"total"`
+	Successful int `json:"successful"`
+	Failed     int `json:"failed"`
+	Pending    int `json:"pending"`
+}
+
+// WorkloadResult is the rebalance result of a single workload.
+type WorkloadResult struct {
+	Workload appsv1alpha1.ObjectReference       `json:"workload"`
+	Result   appsv1alpha1.RebalanceResult       `json:"result"`
+	Reason   appsv1alpha1.RebalanceFailedReason `json:"reason,omitempty"`
+}
+
+// GetWorkloadRebalancerList returns a list of all WorkloadRebalancers in the karmada control-plane.
+func GetWorkloadRebalancerList(client karmadaclientset.Interface, dsQuery *dataselect.DataSelectQuery) (*WorkloadRebalancerList, error) {
+	rebalancers, err := client.AppsV1alpha1().WorkloadRebalancers().List(context.TODO(), helpers.ListEverything)
+	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	return toWorkloadRebalancerList(rebalancers.Items, nonCriticalErrors, dsQuery), nil
+}
+
+func toWorkloadRebalancerList(rebalancers []appsv1alpha1.WorkloadRebalancer, nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery) *WorkloadRebalancerList {
+	result := &WorkloadRebalancerList{
+		Items:    make([]WorkloadRebalancer, 0),
+		ListMeta: types.ListMeta{TotalItems: len(rebalancers)},
+		Errors:   nonCriticalErrors,
+	}
+
+	rebalancerCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(rebalancers), dsQuery)
+	rebalancers = fromCells(rebalancerCells)
+	result.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+
+	for i := range rebalancers {
+		result.Items = append(result.Items, toWorkloadRebalancer(&rebalancers[i], workloadResults(&rebalancers[i])))
+	}
+	return result
+}
+
+func toWorkloadRebalancer(rebalancer *appsv1alpha1.WorkloadRebalancer, results []WorkloadResult) WorkloadRebalancer {
+	summary := RebalanceSummary{Total: len(results)}
+	for _, r := range results {
+		switch r.Result {
+		case appsv1alpha1.RebalanceSuccessful:
+			summary.Successful++
+		case appsv1alpha1.RebalanceFailed:
+			summary.Failed++
+		default:
+			summary.Pending++
+		}
+	}
+	return WorkloadRebalancer{
+		ObjectMeta:              types.NewObjectMeta(rebalancer.ObjectMeta),
+		TypeMeta:                types.NewTypeMeta(types.ResourceKindWorkloadRebalancer),
+		TTLSecondsAfterFinished: rebalancer.Spec.TTLSecondsAfterFinished,
+		FinishTime:              rebalancer.Status.FinishTime,
+		Summary:                 summary,
+	}
+}
+
+// workloadResults returns the result of every workload in spec, a workload is pending until the controller
+// has observed the latest generation and recorded a result for it.
+func workloadResults(rebalancer *appsv1alpha1.WorkloadRebalancer) []WorkloadResult {
+	observed := make(map[appsv1alpha1.ObjectReference]appsv1alpha1.ObservedWorkload)
+	if rebalancer.Status.ObservedGeneration == rebalancer.Generation {
+		for _, w := range rebalancer.Status.ObservedWorkloads {
+			observed[w.Workload] = w
+		}
+	}
+	results := make([]WorkloadResult, 0, len(rebalancer.Spec.Workloads))
+	for _, workload := range rebalancer.Spec.Workloads {
+		result := WorkloadResult{Workload: workload, Result: RebalancePending}
+		if w, ok := observed[workload]; ok && w.Result != "" {
+			result.Result = w.Result
+			result.Reason = w.Reason
+		}
+		results = append(results, result)
+	}
+	return results
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terminal
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+)
+
+// DefaultIdleTimeout is how long a session is kept open without input from the user.
+const DefaultIdleTimeout = 10 * time.Minute
+
+// shells are tried in order when no command is given, probe runs the shell without a TTY to tell whether
+// it exists in the container.
+var shells = []struct {
+	command string
+	probe   []string
+}{
+	{command: "bash", probe: []string{"bash", "-c", "exit 0"}},
+	{command: "sh", probe: []string{"sh", "-c", "exit 0"}},
+	{command: "powershell", probe: []string{"powershell", "-Command", "exit 0"}},
+	{command: "cmd", probe: []string{"cmd", "/c", "exit 0"}},
+}
+
+// CanExec checks with a SelfSubjectAccessReview that the user of the client may exec into the pod.
+func CanExec(ctx context.Context, userClient kubernetes.Interface, namespace, pod string) error {
+	review, err := userClient.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace:   namespace,
+				Verb:        "create",
+				Resource:    "pods",
+				Subresource: "exec",
+				Name:        pod,
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+	if !review.Status.Allowed {
+		return errors.NewForbidden(fmt.Sprintf("exec into pod %s/%s", namespace, pod),
+			fmt.Errorf("access denied: %s", review.Status.Reason))
+	}
+	return nil
+}
+
+// Exec runs the command of the session in its container with a TTY and streams it over the session
+// until the process exits or the session is closed. Without a command, the first shell found in the
+// container is started.
+func Exec(ctx context.Context, config *rest.Config, client kubernetes.Interface, session *Session) error {
+	command := session.Info.Command
+	if len(command) == 0 {
+		shell, err := detectShell(ctx, config, client, session.Info)
+		if err != nil {
+			return err
+		}
+		command = []string{shell}
+	}
+	return stream(ctx, config, client, session.Info, command, remotecommand.StreamOptions{
+		Stdin:             session,
+		Stdout:            session,
+		Stderr:            session,
+		Tty:               true,
+		TerminalSizeQueue: session,
+	})
+}
+
+// detectShell probes the shells in order and returns the first one which runs. The probes don't read
+// the session, so the input of the user only ever goes to the shell started afterwards.
+func detectShell(ctx context.Context, config *rest.Config, client kubernetes.Interface, info SessionInfo) (string, error) {
+	var err error
+	for _, shell := range shells {
+		err = stream(ctx, config, client, info, shell.probe, remotecommand.StreamOptions{
+			Stdout: io.Discard,
+			Stderr: io.Discard,
+		})
+		if err == nil {
+			return shell.command, nil
+		}
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+	}
+	return "", fmt.Errorf("no shell found in container %s: %w", info.Container, err)
+}
+
+func stream(ctx context.Context, config *rest.Config, client kubernetes.Interface, info SessionInfo, command []string, opts remotecommand.StreamOptions) error {
+	req := client.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Name(info.Pod).
+		Namespace(info.Namespace).
+		SubResource("exec").
+		VersionedParams(&v1.PodExecOptions{
+			Container: info.Container,
+			Command:   command,
+			Stdin:     opts.Stdin != nil,
+			Stdout:    opts.Stdout != nil,
+			Stderr:    opts.Stderr != nil,
+			TTY:       opts.Tty,
+		}, scheme.ParameterCodec)
+	executor, err := remotecommand.NewSPDYExecutor(config, "POST", req.URL())
+	if err != nil {
+		return err
+	}
+	return executor.StreamWithContext(ctx, opts)
+}
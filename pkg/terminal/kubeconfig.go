@@ -0,0 +1,61 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terminal
+
+import (
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	karmadaContext = "karmada-apiserver"
+	hostContext    = "host"
+)
+
+// buildKubeconfig returns a kubeconfig authenticating with the token of the user, with a context for
+// the karmada apiserver, the current one, and a context for the host cluster.
+func buildKubeconfig(token string, karmadaConfig, hostConfig *rest.Config) ([]byte, error) {
+	kubeconfig := clientcmdapi.NewConfig()
+	kubeconfig.AuthInfos[karmadaContext] = &clientcmdapi.AuthInfo{Token: token}
+	for name, restConfig := range map[string]*rest.Config{karmadaContext: karmadaConfig, hostContext: hostConfig} {
+		if restConfig == nil {
+			continue
+		}
+		cluster, err := toCluster(restConfig)
+		if err != nil {
+			return nil, err
+		}
+		kubeconfig.Clusters[name] = cluster
+		kubeconfig.Contexts[name] = &clientcmdapi.Context{Cluster: name, AuthInfo: karmadaContext}
+	}
+	kubeconfig.CurrentContext = karmadaContext
+	return clientcmd.Write(*kubeconfig)
+}
+
+func toCluster(restConfig *rest.Config) (*clientcmdapi.Cluster, error) {
+	restConfig = rest.CopyConfig(restConfig)
+	// the CA file of the dashboard is not mounted in the terminal, it's inlined instead
+	if err := rest.LoadTLSFiles(restConfig); err != nil {
+		return nil, err
+	}
+	return &clientcmdapi.Cluster{
+		Server:                   restConfig.Host,
+		CertificateAuthorityData: restConfig.CAData,
+		InsecureSkipTLSVerify:    restConfig.Insecure,
+	}, nil
+}
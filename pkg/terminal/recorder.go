@@ -0,0 +1,102 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terminal
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// SessionInfo describes the container a terminal session is attached to.
+type SessionInfo struct {
+	SessionID string
+	Cluster   string
+	Namespace string
+	Pod       string
+	Container string
+	Command   []string
+	StartTime time.Time
+}
+
+// Recorder records a terminal session, e.g. to audit what was run in the containers. The methods are
+// called synchronously with the session, so they must not block.
+type Recorder interface {
+	// Input is called with the data typed by the user.
+	Input(data []byte)
+	// Output is called with the output of the process.
+	Output(data []byte)
+	// Resize is called when the terminal is resized.
+	Resize(size remotecommand.TerminalSize)
+	// Close is called once the session ends.
+	Close()
+}
+
+// RecorderFactory creates the recorder of a new session, returning nil means the session is not recorded.
+type RecorderFactory func(info SessionInfo) Recorder
+
+var recorders struct {
+	sync.RWMutex
+	factories []RecorderFactory
+}
+
+// RegisterRecorder registers a factory called for every new session.
+func RegisterRecorder(factory RecorderFactory) {
+	recorders.Lock()
+	defer recorders.Unlock()
+	recorders.factories = append(recorders.factories, factory)
+}
+
+func newRecorder(info SessionInfo) Recorder {
+	recorders.RLock()
+	defer recorders.RUnlock()
+	result := multiRecorder{}
+	for _, factory := range recorders.factories {
+		if recorder := factory(info); recorder != nil {
+			result = append(result, recorder)
+		}
+	}
+	return result
+}
+
+// multiRecorder forwards the session to every registered recorder.
+type multiRecorder []Recorder
+
+func (m multiRecorder) Input(data []byte) {
+	for _, r := range m {
+		r.Input(data)
+	}
+}
+
+func (m multiRecorder) Output(data []byte) {
+	for _, r := range m {
+		r.Output(data)
+	}
+}
+
+func (m multiRecorder) Resize(size remotecommand.TerminalSize) {
+	for _, r := range m {
+		r.Resize(size)
+	}
+}
+
+func (m multiRecorder) Close() {
+	for _, r := range m {
+		r.Close()
+	}
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terminal
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+const (
+	// OpStdin is sent by the client with the keys typed by the user.
+	OpStdin = "stdin"
+	// OpResize is sent by the client when the size of the terminal changes.
+	OpResize = "resize"
+	// OpStdout is sent to the client with the output of the process.
+	OpStdout = "stdout"
+	// OpToast is sent to the client with a notice of the server, like the session being closed.
+	OpToast = "toast"
+
+	writeTimeout = 10 * time.Second
+)
+
+// Message is the message exchanged with the client over the websocket.
+type Message struct {
+	Op   string `json:"op"`
+	Data string `json:"data,omitempty"`
+	Rows uint16 `json:"rows,omitempty"`
+	Cols uint16 `json:"cols,omitempty"`
+}
+
+// Session bridges a websocket to the streams of a process started by exec, it implements
+// io.Reader and io.Writer for stdin and stdout and remotecommand.TerminalSizeQueue for resizing.
+type Session struct {
+	ID   string
+	Info SessionInfo
+
+	conn        *websocket.Conn
+	idleTimeout time.Duration
+	recorder    Recorder
+	sizes       chan remotecommand.TerminalSize
+	done        chan struct{}
+	closeOnce   sync.Once
+	writeLock   sync.Mutex
+
+	// pending holds the stdin data that did not fit in the buffer of the last Read.
+	pending []byte
+}
+
+// NewSession creates a session over the websocket. The session is closed when the client sends no
+// input for idleTimeout, zero means no timeout.
+func NewSession(conn *websocket.Conn, info SessionInfo, idleTimeout time.Duration) (*Session, error) {
+	id, err := newSessionID()
+	if err != nil {
+		return nil, err
+	}
+	info.SessionID = id
+	info.StartTime = time.Now()
+	return &Session{
+		ID:          id,
+		Info:        info,
+		conn:        conn,
+		idleTimeout: idleTimeout,
+		recorder:    newRecorder(info),
+		sizes:       make(chan remotecommand.TerminalSize, 1),
+		done:        make(chan struct{}),
+	}, nil
+}
+
+// Read reads the input of the user, resize messages are queued for Next.
+func (s *Session) Read(p []byte) (int, error) {
+	for len(s.pending) == 0 {
+		if s.idleTimeout > 0 {
+			_ = s.conn.SetReadDeadline(time.Now().Add(s.idleTimeout))
+		}
+		_, data, err := s.conn.ReadMessage()
+		if err != nil {
+			if isTimeout(err) {
+				s.Toast(fmt.Sprintf("session closed after %s without input", s.idleTimeout))
+			}
+			s.Close()
+			return 0, io.EOF
+		}
+		msg := Message{}
+		if err := json.Unmarshal(data, &msg); err != nil {
+			continue
+		}
+		switch msg.Op {
+		case OpStdin:
+			s.pending = []byte(msg.Data)
+			s.recorder.Input(s.pending)
+		case OpResize:
+			size := remotecommand.TerminalSize{Width: msg.Cols, Height: msg.Rows}
+			s.recorder.Resize(size)
+			// only the latest size matters
+			select {
+			case <-s.sizes:
+			default:
+			}
+			s.sizes <- size
+		}
+	}
+	n := copy(p, s.pending)
+	s.pending = s.pending[n:]
+	return n, nil
+}
+
+// Write sends the output of the process to the client.
+func (s *Session) Write(p []byte) (int, error) {
+	s.recorder.Output(p)
+	if err := s.send(Message{Op: OpStdout, Data: string(p)}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Toast sends a notice to the client.
+func (s *Session) Toast(msg string) {
+	_ = s.send(Message{Op: OpToast, Data: msg})
+}
+
+// Next returns the new size of the terminal, nil once the session is closed.
+func (s *Session) Next() *remotecommand.TerminalSize {
+	select {
+	case size := <-s.sizes:
+		return &size
+	case <-s.done:
+		return nil
+	}
+}
+
+// Done is closed when the session is closed.
+func (s *Session) Done() <-chan struct{} {
+	return s.done
+}
+
+// Close closes the websocket and ends the recording of the session.
+func (s *Session) Close() {
+	s.closeOnce.Do(func() {
+		close(s.done)
+		s.recorder.Close()
+		s.writeLock.Lock()
+		_ = s.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+			time.Now().Add(writeTimeout))
+		s.writeLock.Unlock()
+		_ = s.conn.Close()
+	})
+}
+
+func (s *Session) send(msg Message) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+	_ = s.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	return s.conn.WriteMessage(websocket.TextMessage, data)
+}
+
+func isTimeout(err error) bool {
+	netErr, ok := err.(interface{ Timeout() bool })
+	return ok && netErr.Timeout()
+}
+
+func newSessionID() (string, error) {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bytes), nil
+}
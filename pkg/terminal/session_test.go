@@ -0,0 +1,136 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terminal
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// newTestSession returns a session served over a websocket and the client end of it.
+func newTestSession(t *testing.T, idleTimeout time.Duration) (*Session, *websocket.Conn) {
+	sessions := make(chan *Session, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("Upgrade() error = %v", err)
+			return
+		}
+		session, err := NewSession(conn, SessionInfo{Cluster: "member1", Namespace: "default", Pod: "nginx"}, idleTimeout)
+		if err != nil {
+			t.Errorf("NewSession() error = %v", err)
+		}
+		sessions <- session
+	}))
+	t.Cleanup(server.Close)
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return <-sessions, conn
+}
+
+func TestSessionRead(t *testing.T) {
+	session, conn := newTestSession(t, 0)
+	defer session.Close()
+
+	for _, msg := range []Message{
+		{Op: OpResize, Rows: 24, Cols: 80},
+		{Op: OpStdin, Data: "ls -l\n"},
+	} {
+		if err := conn.WriteJSON(msg); err != nil {
+			t.Fatalf("WriteJSON() error = %v", err)
+		}
+	}
+
+	// the stdin message is read in two parts when the buffer is small
+	buf := make([]byte, 4)
+	var input []byte
+	for len(input) < len("ls -l\n") {
+		n, err := session.Read(buf)
+		if err != nil {
+			t.Fatalf("Read() error = %v", err)
+		}
+		input = append(input, buf[:n]...)
+	}
+	if string(input) != "ls -l\n" {
+		t.Errorf("Read() = %q, want %q", input, "ls -l\n")
+	}
+	if size := session.Next(); size == nil || *size != (remotecommand.TerminalSize{Width: 80, Height: 24}) {
+		t.Errorf("Next() = %v, want 80x24", size)
+	}
+}
+
+func TestSessionIdleTimeout(t *testing.T) {
+	session, conn := newTestSession(t, 50*time.Millisecond)
+
+	if _, err := session.Read(make([]byte, 8)); err != io.EOF {
+		t.Fatalf("Read() error = %v, want EOF", err)
+	}
+	select {
+	case <-session.Done():
+	default:
+		t.Errorf("session is not closed after the idle timeout")
+	}
+	msg := Message{}
+	if err := conn.ReadJSON(&msg); err != nil || msg.Op != OpToast {
+		t.Errorf("ReadJSON() = %v, %v, want a toast", msg, err)
+	}
+}
+
+func TestCanExec(t *testing.T) {
+	cases := []struct {
+		name    string
+		allowed bool
+		wantErr bool
+	}{
+		{name: "allowed", allowed: true},
+		{name: "denied", allowed: false, wantErr: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset()
+			client.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+				attributes := review.Spec.ResourceAttributes
+				if attributes.Resource != "pods" || attributes.Subresource != "exec" || attributes.Name != "nginx" {
+					t.Errorf("unexpected resource attributes %+v", attributes)
+				}
+				review.Status.Allowed = tc.allowed
+				return true, review, nil
+			})
+			err := CanExec(context.TODO(), client, "default", "nginx")
+			if (err != nil) != tc.wantErr {
+				t.Errorf("CanExec() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
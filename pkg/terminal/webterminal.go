@@ -0,0 +1,477 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terminal
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/config"
+)
+
+const (
+	// WebTerminalAppLabelValue is the value of the app label of the web terminal pods.
+	WebTerminalAppLabelValue = "karmada-web-terminal"
+	// WebTerminalUserLabel holds the hash of the name of the user owning a web terminal.
+	WebTerminalUserLabel = "dashboard.karmada.io/terminal-user"
+	// WebTerminalUsernameAnnotation holds the name of the user owning a web terminal.
+	WebTerminalUsernameAnnotation = "dashboard.karmada.io/terminal-username"
+	// WebTerminalLastActiveAnnotation holds the last time somebody was attached to a web terminal.
+	WebTerminalLastActiveAnnotation = "dashboard.karmada.io/terminal-last-active"
+
+	defaultWebTerminalImage       = "karmada/karmada-dashboard-web-terminal:latest"
+	defaultMaxSessionsPerUser     = 1
+	defaultWebTerminalIdleTimeout = 30 * time.Minute
+	garbageCollectInterval        = time.Minute
+	webTerminalContainer          = "terminal"
+	kubeconfigMountPath           = "/etc/karmada-dashboard"
+	kubeconfigKey                 = "kubeconfig"
+)
+
+// WebTerminal is a terminal pod of a user.
+type WebTerminal struct {
+	Name       string      `json:"name"`
+	Image      string      `json:"image"`
+	Phase      v1.PodPhase `json:"phase"`
+	Ready      bool        `json:"ready"`
+	Attached   int         `json:"attached"`
+	CreatedAt  metav1.Time `json:"createdAt"`
+	LastActive metav1.Time `json:"lastActive"`
+}
+
+// User is the user owning web terminals, as authenticated by the karmada apiserver.
+type User struct {
+	Name  string
+	Token string
+}
+
+func (u User) hash() string {
+	sum := sha256.Sum256([]byte(u.Name))
+	return hex.EncodeToString(sum[:])[:16]
+}
+
+var manager struct {
+	sync.Mutex
+	client    kubernetes.Interface
+	namespace string
+	// attached counts the sessions attached to each terminal pod.
+	attached map[string]int
+}
+
+// creating serializes the creation of terminals per user, the limit of sessions is checked against the
+// listed pods and concurrent requests of a user would otherwise all pass it.
+var creating struct {
+	sync.Mutex
+	users map[string]*userLock
+}
+
+type userLock struct {
+	sync.Mutex
+	// refs counts the requests holding or waiting for the lock, it's dropped when nobody needs it.
+	refs int
+}
+
+// lockUser locks the creation of terminals of the user and returns the function releasing it.
+func lockUser(user *User) func() {
+	key := user.hash()
+	creating.Lock()
+	if creating.users == nil {
+		creating.users = map[string]*userLock{}
+	}
+	lock, ok := creating.users[key]
+	if !ok {
+		lock = &userLock{}
+		creating.users[key] = lock
+	}
+	lock.refs++
+	creating.Unlock()
+
+	lock.Lock()
+	return func() {
+		lock.Unlock()
+		creating.Lock()
+		if lock.refs--; lock.refs == 0 {
+			delete(creating.users, key)
+		}
+		creating.Unlock()
+	}
+}
+
+// InitWebTerminals sets the host cluster namespace the terminal pods live in and starts collecting the
+// idle terminals until stop is closed.
+func InitWebTerminals(kubeClient kubernetes.Interface, namespace string, stop <-chan struct{}) {
+	manager.Lock()
+	manager.client, manager.namespace, manager.attached = kubeClient, namespace, map[string]int{}
+	manager.Unlock()
+	go wait.Until(collectIdleWebTerminals, garbageCollectInterval, stop)
+}
+
+// ReviewUser authenticates the token against the karmada apiserver.
+func ReviewUser(ctx context.Context, karmadaKubeClient kubernetes.Interface, token string) (*User, error) {
+	review, err := karmadaKubeClient.AuthenticationV1().TokenReviews().Create(ctx, &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{Token: token},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !review.Status.Authenticated || review.Status.User.Username == "" {
+		return nil, errors.NewUnauthorized(review.Status.Error)
+	}
+	return &User{Name: review.Status.User.Username, Token: token}, nil
+}
+
+// ListWebTerminals lists the terminals of the user.
+func ListWebTerminals(ctx context.Context, user *User) ([]WebTerminal, error) {
+	kubeClient, namespace, err := webTerminalClient()
+	if err != nil {
+		return nil, err
+	}
+	pods, err := listUserPods(ctx, kubeClient, namespace, user)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]WebTerminal, 0, len(pods))
+	for i := range pods {
+		result = append(result, toWebTerminal(&pods[i]))
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].CreatedAt.Before(&result[j].CreatedAt) })
+	return result, nil
+}
+
+// CreateWebTerminal creates a terminal pod for the user, with a kubeconfig of the user's own token for
+// the karmada apiserver and the host cluster.
+func CreateWebTerminal(ctx context.Context, user *User, karmadaConfig, hostConfig *rest.Config) (*WebTerminal, error) {
+	kubeClient, namespace, err := webTerminalClient()
+	if err != nil {
+		return nil, err
+	}
+	terminalConfig := config.GetDashboardConfig()
+	maxSessions := terminalConfig.Terminal.MaxSessionsPerUser
+	if maxSessions <= 0 {
+		maxSessions = defaultMaxSessionsPerUser
+	}
+	// the lock is held until the terminal is created, the next request of the user then counts its pod.
+	unlock := lockUser(user)
+	defer unlock()
+	pods, err := listUserPods(ctx, kubeClient, namespace, user)
+	if err != nil {
+		return nil, err
+	}
+	if len(pods) >= maxSessions {
+		return nil, errors.NewBadRequest(fmt.Sprintf("at most %d web terminals are allowed per user, delete one first", maxSessions))
+	}
+
+	kubeconfig, err := buildKubeconfig(user.Token, karmadaConfig, hostConfig)
+	if err != nil {
+		return nil, err
+	}
+	image, registry := webTerminalImage(terminalConfig)
+	suffix, err := newSessionID()
+	if err != nil {
+		return nil, err
+	}
+	name := fmt.Sprintf("karmada-terminal-%s-%s", user.hash(), suffix[:6])
+	var dockerConfig []byte
+	if registry != nil && registry.User != "" {
+		if dockerConfig, err = dockerConfigJSON(registry); err != nil {
+			return nil, err
+		}
+	}
+
+	// the pod is created first so that the secrets are owned by it from the start and garbage collected
+	// with it, the pod waits for its secret volume until the secrets exist.
+	pod, err := kubeClient.CoreV1().Pods(namespace).Create(ctx, webTerminalPod(name, namespace, image, user, dockerConfig != nil), metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	owner := metav1.NewControllerRef(pod, v1.SchemeGroupVersion.WithKind("Pod"))
+	secrets := []*v1.Secret{{
+		ObjectMeta: webTerminalObjectMeta(name, namespace, user),
+		Type:       v1.SecretTypeOpaque,
+		Data:       map[string][]byte{kubeconfigKey: kubeconfig},
+	}}
+	if dockerConfig != nil {
+		secrets = append(secrets, &v1.Secret{
+			ObjectMeta: webTerminalObjectMeta(name+"-registry", namespace, user),
+			Type:       v1.SecretTypeDockerConfigJson,
+			Data:       map[string][]byte{v1.DockerConfigJsonKey: dockerConfig},
+		})
+	}
+	for _, secret := range secrets {
+		secret.OwnerReferences = []metav1.OwnerReference{*owner}
+		if _, err := kubeClient.CoreV1().Secrets(namespace).Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+			if err := kubeClient.CoreV1().Pods(namespace).Delete(context.TODO(), name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+				klog.ErrorS(err, "Failed to delete web terminal", "pod", name)
+			}
+			return nil, err
+		}
+	}
+	terminal := toWebTerminal(pod)
+	return &terminal, nil
+}
+
+// DeleteWebTerminal deletes a terminal of the user.
+func DeleteWebTerminal(ctx context.Context, user *User, name string) error {
+	kubeClient, namespace, err := webTerminalClient()
+	if err != nil {
+		return err
+	}
+	if _, err := getUserPod(ctx, kubeClient, namespace, user, name); err != nil {
+		return err
+	}
+	return kubeClient.CoreV1().Pods(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+}
+
+// AttachWebTerminal runs a shell in a ready terminal of the user over the session, the terminal is not
+// collected while a session is attached to it.
+func AttachWebTerminal(ctx context.Context, user *User, name string, hostConfig *rest.Config, session *Session) error {
+	kubeClient, namespace, err := webTerminalClient()
+	if err != nil {
+		return err
+	}
+	pod, err := getUserPod(ctx, kubeClient, namespace, user, name)
+	if err != nil {
+		return err
+	}
+	if !podReady(pod) {
+		return errors.NewBadRequest(fmt.Sprintf("web terminal %s is not ready yet", name))
+	}
+
+	manager.Lock()
+	manager.attached[name]++
+	manager.Unlock()
+	defer func() {
+		manager.Lock()
+		if manager.attached[name]--; manager.attached[name] <= 0 {
+			delete(manager.attached, name)
+		}
+		manager.Unlock()
+		touchWebTerminal(kubeClient, namespace, name)
+	}()
+	touchWebTerminal(kubeClient, namespace, name)
+
+	session.Info.Namespace, session.Info.Pod, session.Info.Container = namespace, name, webTerminalContainer
+	return Exec(ctx, hostConfig, kubeClient, session)
+}
+
+// collectIdleWebTerminals deletes the terminals nobody has been attached to for the idle timeout and
+// the terminals whose pod has terminated.
+func collectIdleWebTerminals() {
+	kubeClient, namespace, err := webTerminalClient()
+	if err != nil {
+		return
+	}
+	idleTimeout := defaultWebTerminalIdleTimeout
+	if minutes := config.GetDashboardConfig().Terminal.IdleTimeoutMinutes; minutes > 0 {
+		idleTimeout = time.Duration(minutes) * time.Minute
+	}
+	pods, err := kubeClient.CoreV1().Pods(namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: labels.Set{"app": WebTerminalAppLabelValue}.String(),
+	})
+	if err != nil {
+		klog.ErrorS(err, "Failed to list web terminals")
+		return
+	}
+
+	manager.Lock()
+	attached := make(map[string]int, len(manager.attached))
+	for name, count := range manager.attached {
+		attached[name] = count
+	}
+	manager.Unlock()
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		terminated := pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed
+		if !terminated && (attached[pod.Name] > 0 || time.Since(lastActive(pod)) < idleTimeout) {
+			continue
+		}
+		klog.InfoS("Deleting idle web terminal", "pod", pod.Name, "user", pod.Annotations[WebTerminalUsernameAnnotation])
+		if err := kubeClient.CoreV1().Pods(namespace).Delete(context.TODO(), pod.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			klog.ErrorS(err, "Failed to delete idle web terminal", "pod", pod.Name)
+		}
+	}
+}
+
+func webTerminalClient() (kubernetes.Interface, string, error) {
+	manager.Lock()
+	defer manager.Unlock()
+	if manager.client == nil {
+		return nil, "", fmt.Errorf("web terminals are not initialized")
+	}
+	return manager.client, manager.namespace, nil
+}
+
+func listUserPods(ctx context.Context, kubeClient kubernetes.Interface, namespace string, user *User) ([]v1.Pod, error) {
+	pods, err := kubeClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.Set{"app": WebTerminalAppLabelValue, WebTerminalUserLabel: user.hash()}.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pods.Items, nil
+}
+
+// getUserPod returns the terminal pod, the terminals of other users are reported as not found.
+func getUserPod(ctx context.Context, kubeClient kubernetes.Interface, namespace string, user *User, name string) (*v1.Pod, error) {
+	pod, err := kubeClient.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if pod.Labels["app"] != WebTerminalAppLabelValue || pod.Labels[WebTerminalUserLabel] != user.hash() ||
+		pod.Annotations[WebTerminalUsernameAnnotation] != user.Name {
+		return nil, errors.NewNotFound(fmt.Sprintf("web terminal %s not found", name))
+	}
+	return pod, nil
+}
+
+func touchWebTerminal(kubeClient kubernetes.Interface, namespace, name string) {
+	patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, WebTerminalLastActiveAnnotation, time.Now().UTC().Format(time.RFC3339))
+	if _, err := kubeClient.CoreV1().Pods(namespace).Patch(context.TODO(), name, "application/merge-patch+json",
+		[]byte(patch), metav1.PatchOptions{}); err != nil && !errors.IsNotFound(err) {
+		klog.ErrorS(err, "Failed to record web terminal activity", "pod", name)
+	}
+}
+
+func lastActive(pod *v1.Pod) time.Time {
+	if value, err := time.Parse(time.RFC3339, pod.Annotations[WebTerminalLastActiveAnnotation]); err == nil {
+		return value
+	}
+	return pod.CreationTimestamp.Time
+}
+
+func podReady(pod *v1.Pod) bool {
+	if pod.Status.Phase != v1.PodRunning {
+		return false
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodReady {
+			return condition.Status == v1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func toWebTerminal(pod *v1.Pod) WebTerminal {
+	manager.Lock()
+	attached := manager.attached[pod.Name]
+	manager.Unlock()
+	terminal := WebTerminal{
+		Name:       pod.Name,
+		Phase:      pod.Status.Phase,
+		Ready:      podReady(pod),
+		Attached:   attached,
+		CreatedAt:  pod.CreationTimestamp,
+		LastActive: metav1.NewTime(lastActive(pod)),
+	}
+	if len(pod.Spec.Containers) > 0 {
+		terminal.Image = pod.Spec.Containers[0].Image
+	}
+	return terminal
+}
+
+func webTerminalObjectMeta(name, namespace string, user *User) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:        name,
+		Namespace:   namespace,
+		Labels:      map[string]string{"app": WebTerminalAppLabelValue, WebTerminalUserLabel: user.hash()},
+		Annotations: map[string]string{WebTerminalUsernameAnnotation: user.Name},
+	}
+}
+
+func webTerminalPod(name, namespace, image string, user *User, pullSecret bool) *v1.Pod {
+	meta := webTerminalObjectMeta(name, namespace, user)
+	meta.Annotations[WebTerminalLastActiveAnnotation] = time.Now().UTC().Format(time.RFC3339)
+	pod := &v1.Pod{
+		ObjectMeta: meta,
+		Spec: v1.PodSpec{
+			// the terminal acts with the token of the user only, not with a service account of the host cluster
+			AutomountServiceAccountToken: new(bool),
+			RestartPolicy:                v1.RestartPolicyAlways,
+			Containers: []v1.Container{{
+				Name:            webTerminalContainer,
+				Image:           image,
+				ImagePullPolicy: v1.PullIfNotPresent,
+				Env:             []v1.EnvVar{{Name: "KUBECONFIG", Value: kubeconfigMountPath + "/" + kubeconfigKey}},
+				VolumeMounts:    []v1.VolumeMount{{Name: kubeconfigKey, MountPath: kubeconfigMountPath, ReadOnly: true}},
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("100m"), v1.ResourceMemory: resource.MustParse("128Mi")},
+					Limits:   v1.ResourceList{v1.ResourceCPU: resource.MustParse("500m"), v1.ResourceMemory: resource.MustParse("512Mi")},
+				},
+			}},
+			Volumes: []v1.Volume{{
+				Name:         kubeconfigKey,
+				VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: name}},
+			}},
+		},
+	}
+	if pullSecret {
+		pod.Spec.ImagePullSecrets = []v1.LocalObjectReference{{Name: name + "-registry"}}
+	}
+	return pod
+}
+
+// webTerminalImage returns the image of the terminal pods prefixed with the configured docker registry.
+func webTerminalImage(dashboardConfig config.DashboardConfig) (string, *config.DockerRegistry) {
+	image := dashboardConfig.Terminal.Image
+	if image == "" {
+		image = defaultWebTerminalImage
+	}
+	var registry *config.DockerRegistry
+	if dashboardConfig.Terminal.Registry != "" {
+		for i := range dashboardConfig.DockerRegistries {
+			if dashboardConfig.DockerRegistries[i].Name == dashboardConfig.Terminal.Registry {
+				registry = &dashboardConfig.DockerRegistries[i]
+				break
+			}
+		}
+	}
+	if registry == nil {
+		return image, nil
+	}
+	return registry.Host() + "/" + image, registry
+}
+
+func dockerConfigJSON(registry *config.DockerRegistry) ([]byte, error) {
+	auth := base64.StdEncoding.EncodeToString([]byte(registry.User + ":" + registry.Password))
+	return json.Marshal(map[string]interface{}{
+		"auths": map[string]interface{}{
+			registry.Host(): map[string]string{
+				"username": registry.User,
+				"password": registry.Password,
+				"auth":     auth,
+			},
+		},
+	})
+}
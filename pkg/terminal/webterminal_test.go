@@ -0,0 +1,200 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terminal
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/karmada-io/dashboard/pkg/config"
+)
+
+func TestWebTerminalImage(t *testing.T) {
+	registries := []config.DockerRegistry{
+		{Name: "hub", URL: "https://registry.example.com/"},
+		{Name: "mirror", URL: "mirror.example.com", User: "admin", Password: "secret"},
+	}
+	cases := []struct {
+		name         string
+		config       config.DashboardConfig
+		wantImage    string
+		wantRegistry string
+	}{
+		{
+			name:      "no registry",
+			wantImage: defaultWebTerminalImage,
+		},
+		{
+			name:      "no registry named",
+			config:    config.DashboardConfig{DockerRegistries: registries},
+			wantImage: defaultWebTerminalImage,
+		},
+		{
+			name: "unknown registry",
+			config: config.DashboardConfig{
+				DockerRegistries: registries,
+				Terminal:         config.TerminalConfig{Registry: "unknown"},
+			},
+			wantImage: defaultWebTerminalImage,
+		},
+		{
+			name: "named registry and image",
+			config: config.DashboardConfig{
+				DockerRegistries: registries,
+				Terminal:         config.TerminalConfig{Registry: "mirror", Image: "karmada/terminal:v1"},
+			},
+			wantImage:    "mirror.example.com/karmada/terminal:v1",
+			wantRegistry: "mirror",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			image, registry := webTerminalImage(tc.config)
+			if image != tc.wantImage {
+				t.Errorf("webTerminalImage() image = %s, want %s", image, tc.wantImage)
+			}
+			if (registry == nil && tc.wantRegistry != "") || (registry != nil && registry.Name != tc.wantRegistry) {
+				t.Errorf("webTerminalImage() registry = %v, want %s", registry, tc.wantRegistry)
+			}
+		})
+	}
+}
+
+func TestWebTerminalLifecycle(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	manager.client, manager.namespace, manager.attached = kubeClient, "karmada-system", map[string]int{}
+	defer func() { manager.client = nil }()
+	alice, bob := &User{Name: "alice", Token: "alice-token"}, &User{Name: "bob", Token: "bob-token"}
+	restConfig := &rest.Config{Host: "https://karmada-apiserver:5443", TLSClientConfig: rest.TLSClientConfig{Insecure: true}}
+
+	terminal, err := CreateWebTerminal(context.TODO(), alice, restConfig, restConfig)
+	if err != nil {
+		t.Fatalf("CreateWebTerminal() error = %v", err)
+	}
+	if _, err := CreateWebTerminal(context.TODO(), alice, restConfig, restConfig); err == nil {
+		t.Errorf("CreateWebTerminal() beyond the limit of sessions should fail")
+	}
+	secret, err := kubeClient.CoreV1().Secrets("karmada-system").Get(context.TODO(), terminal.Name, metav1.GetOptions{})
+	if err != nil || len(secret.Data[kubeconfigKey]) == 0 || len(secret.OwnerReferences) != 1 {
+		t.Errorf("kubeconfig secret = %v, %v, want a kubeconfig owned by the pod", secret, err)
+	}
+	if err := DeleteWebTerminal(context.TODO(), bob, terminal.Name); err == nil {
+		t.Errorf("DeleteWebTerminal() of another user should fail")
+	}
+
+	// the terminal is collected once it has been idle for longer than the timeout
+	collectIdleWebTerminals()
+	if terminals, _ := ListWebTerminals(context.TODO(), alice); len(terminals) != 1 {
+		t.Fatalf("ListWebTerminals() = %v, want the new terminal", terminals)
+	}
+	pod, _ := kubeClient.CoreV1().Pods("karmada-system").Get(context.TODO(), terminal.Name, metav1.GetOptions{})
+	pod.Annotations[WebTerminalLastActiveAnnotation] = time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	pod.Status.Phase = v1.PodRunning
+	if _, err := kubeClient.CoreV1().Pods("karmada-system").Update(context.TODO(), pod, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	manager.attached[terminal.Name] = 1
+	collectIdleWebTerminals()
+	if terminals, _ := ListWebTerminals(context.TODO(), alice); len(terminals) != 1 {
+		t.Errorf("ListWebTerminals() = %v, an attached terminal must not be collected", terminals)
+	}
+	delete(manager.attached, terminal.Name)
+	collectIdleWebTerminals()
+	if terminals, _ := ListWebTerminals(context.TODO(), alice); len(terminals) != 0 {
+		t.Errorf("ListWebTerminals() = %v, want the idle terminal collected", terminals)
+	}
+}
+
+func TestCreateWebTerminalSecretFailure(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	kubeClient.PrependReactor("create", "secrets", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, fmt.Errorf("secrets are forbidden")
+	})
+	manager.client, manager.namespace, manager.attached = kubeClient, "karmada-system", map[string]int{}
+	defer func() { manager.client = nil }()
+	alice := &User{Name: "alice", Token: "alice-token"}
+	restConfig := &rest.Config{Host: "https://karmada-apiserver:5443", TLSClientConfig: rest.TLSClientConfig{Insecure: true}}
+
+	if _, err := CreateWebTerminal(context.TODO(), alice, restConfig, restConfig); err == nil {
+		t.Errorf("CreateWebTerminal() without its secret should fail")
+	}
+	if terminals, _ := ListWebTerminals(context.TODO(), alice); len(terminals) != 0 {
+		t.Errorf("ListWebTerminals() = %v, want the pod of the failed terminal deleted", terminals)
+	}
+}
+
+func TestCreateWebTerminalConcurrently(t *testing.T) {
+	kubeClient := slowListClient{fake.NewSimpleClientset()}
+	manager.client, manager.namespace, manager.attached = kubeClient, "karmada-system", map[string]int{}
+	defer func() { manager.client = nil }()
+	users := []*User{{Name: "alice", Token: "alice-token"}, {Name: "bob", Token: "bob-token"}}
+	restConfig := &rest.Config{Host: "https://karmada-apiserver:5443", TLSClientConfig: rest.TLSClientConfig{Insecure: true}}
+
+	var wg sync.WaitGroup
+	for _, user := range users {
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, _ = CreateWebTerminal(context.TODO(), user, restConfig, restConfig)
+			}()
+		}
+	}
+	wg.Wait()
+
+	for _, user := range users {
+		if terminals, _ := ListWebTerminals(context.TODO(), user); len(terminals) != defaultMaxSessionsPerUser {
+			t.Errorf("ListWebTerminals(%s) = %d terminals, want %d", user.Name, len(terminals), defaultMaxSessionsPerUser)
+		}
+	}
+	if len(creating.users) != 0 {
+		t.Errorf("locks of %d users are left after the terminals are created", len(creating.users))
+	}
+}
+
+// slowListClient returns the listed pods late, outside the lock of the fake clientset, to widen the window
+// between listing the terminals of a user and creating the pod.
+type slowListClient struct{ *fake.Clientset }
+
+func (c slowListClient) CoreV1() corev1client.CoreV1Interface {
+	return slowListCoreV1{c.Clientset.CoreV1()}
+}
+
+type slowListCoreV1 struct{ corev1client.CoreV1Interface }
+
+func (c slowListCoreV1) Pods(namespace string) corev1client.PodInterface {
+	return slowListPods{c.CoreV1Interface.Pods(namespace)}
+}
+
+type slowListPods struct{ corev1client.PodInterface }
+
+func (p slowListPods) List(ctx context.Context, opts metav1.ListOptions) (*v1.PodList, error) {
+	pods, err := p.PodInterface.List(ctx, opts)
+	time.Sleep(10 * time.Millisecond)
+	return pods, err
+}